@@ -7,6 +7,7 @@ require (
 	github.com/BurntSushi/toml v0.4.1
 	github.com/MakeNowJust/heredoc v1.0.0
 	github.com/Nerzal/gocloak/v7 v7.11.0
+	github.com/Shopify/sarama v1.30.0
 	github.com/aerogear/charmil v0.8.3
 	github.com/bmizerany/assert v0.0.0-20160611221934-b7ed37b82869 // indirect
 	github.com/briandowns/spinner v1.18.0
@@ -14,8 +15,10 @@ require (
 	github.com/fatih/color v1.13.0
 	github.com/golang-jwt/jwt/v4 v4.2.0
 	github.com/google/go-github/v39 v39.2.0
+	github.com/jhump/protoreflect v1.10.1
 	github.com/kataras/tablewriter v0.0.0-20180708051242-e063d29b7c23 // indirect
 	github.com/landoop/tableprinter v0.0.0-20201125135848-89e81fc956e7
+	github.com/linkedin/goavro/v2 v2.10.1
 	github.com/mattn/go-isatty v0.0.14
 	github.com/mattn/go-runewidth v0.0.13 // indirect
 	github.com/nicksnyder/go-i18n/v2 v2.1.2
@@ -31,11 +34,13 @@ require (
 	github.com/segmentio/backo-go v0.0.0-20200129164019-23eae7c10bd3 // indirect
 	github.com/spf13/cobra v1.3.0
 	github.com/spf13/pflag v1.0.5
+	github.com/xeipuuv/gojsonschema v1.2.0
 	github.com/xtgo/uuid v0.0.0-20140804021211-a0b114877d4c
 	gitlab.com/c0b/go-ordered-json v0.0.0-20201030195603-febf46534d5a
 	golang.org/x/oauth2 v0.0.0-20211104180415-d3ed0bb246c8
 	golang.org/x/text v0.3.7
 	golang.org/x/tools v0.1.7 // indirect
+	google.golang.org/protobuf v1.27.1
 	gopkg.in/segmentio/analytics-go.v3 v3.1.0
 	gopkg.in/yaml.v2 v2.4.0
 	k8s.io/api v0.22.4
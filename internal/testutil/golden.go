@@ -0,0 +1,32 @@
+package testutil
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the tests")
+
+// AssertGolden compares the output of a test with the "testdata/<name>.golden" file of the package.
+// The golden file is written with the output first when the tests are run with the "-update" flag
+func AssertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	goldenFile := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(goldenFile, got, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := ioutil.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != string(want) {
+		t.Errorf("got \n%v\nwant\n%v", string(got), string(want))
+	}
+}
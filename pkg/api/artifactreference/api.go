@@ -0,0 +1,235 @@
+package artifactreference
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+// extendedContentType is the content type of requests which send the content of an artifact with its references
+const extendedContentType = "application/create.extended+json"
+
+// ReferenceType is the direction of the references of an artifact version
+type ReferenceType string
+
+const (
+	// ReferenceTypeOutbound are the artifacts referenced by an artifact version
+	ReferenceTypeOutbound ReferenceType = "OUTBOUND"
+	// ReferenceTypeInbound are the artifacts which reference an artifact version
+	ReferenceTypeInbound ReferenceType = "INBOUND"
+)
+
+// ArtifactReference is a reference from the content of an artifact version,
+// such as a Protobuf import, to a version of another artifact
+type ArtifactReference struct {
+	GroupID    string `json:"groupId" yaml:"groupId"`
+	ArtifactID string `json:"artifactId" yaml:"artifactId"`
+	Version    string `json:"version" yaml:"version"`
+	Name       string `json:"name" yaml:"name"`
+}
+
+// CreateArtifactRequest contains the content and references of a new artifact
+type CreateArtifactRequest struct {
+	ArtifactID   string
+	ArtifactType string
+	Version      string
+	Name         string
+	Description  string
+	Content      []byte
+	References   []ArtifactReference
+}
+
+// UpdateArtifactRequest contains the content and references of a new artifact version
+type UpdateArtifactRequest struct {
+	Version     string
+	Name        string
+	Description string
+	Content     []byte
+	References  []ArtifactReference
+}
+
+// contentCreateRequest is the body of requests with the extended content type
+type contentCreateRequest struct {
+	Content    string              `json:"content"`
+	References []ArtifactReference `json:"references"`
+}
+
+// API is the API definition for artifact references,
+// which are not part of the Service Registry instance SDK
+type API interface {
+	CreateArtifact(ctx context.Context, group string, request CreateArtifactRequest) (*registryinstanceclient.ArtifactMetaData, *http.Response, error)
+	UpdateArtifact(ctx context.Context, group string, artifactID string, request UpdateArtifactRequest) (*registryinstanceclient.ArtifactMetaData, *http.Response, error)
+	GetReferencesByGlobalID(ctx context.Context, globalID int64, refType ReferenceType) ([]ArtifactReference, *http.Response, error)
+}
+
+// Config defines the available configuration options
+// to customize the API client settings
+type Config struct {
+	// HTTPClient is a custom HTTP client
+	HTTPClient *http.Client
+	// BaseURL is the URL of the core API of the registry instance
+	BaseURL string
+	// UserAgent sets the user agent of the requests
+	UserAgent string
+}
+
+// NewAPIClient returns a new API client
+// using a custom config
+func NewAPIClient(cfg *Config) API {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	return &APIClient{
+		baseURL:    strings.TrimSuffix(cfg.BaseURL, "/"),
+		httpClient: cfg.HTTPClient,
+		userAgent:  cfg.UserAgent,
+	}
+}
+
+// NewAPIClientFromInstance returns a new API client
+// which uses the configuration of a registry instance client.
+// Commands create the references client from the instance client they already have,
+// so that it shares its URL, authenticated HTTP client and user agent
+func NewAPIClientFromInstance(instanceAPI *registryinstanceclient.APIClient) API {
+	instanceCfg := instanceAPI.GetConfig()
+
+	cfg := &Config{
+		HTTPClient: instanceCfg.HTTPClient,
+		UserAgent:  instanceCfg.UserAgent,
+	}
+	if len(instanceCfg.Servers) > 0 {
+		cfg.BaseURL = instanceCfg.Servers[0].URL
+	}
+
+	return NewAPIClient(cfg)
+}
+
+type APIClient struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+}
+
+// APIError is an error returned by the registry instance
+type APIError struct {
+	StatusCode int
+	Model      registryinstanceclient.Error
+}
+
+func (e *APIError) Error() string {
+	if e.Model.GetMessage() == "" {
+		return http.StatusText(e.StatusCode)
+	}
+	return e.Model.GetName() + ": " + e.Model.GetMessage()
+}
+
+// CreateArtifact creates an artifact whose content references other artifacts
+func (c *APIClient) CreateArtifact(ctx context.Context, group string, request CreateArtifactRequest) (*registryinstanceclient.ArtifactMetaData, *http.Response, error) {
+	headers := map[string]string{
+		"X-Registry-ArtifactId":   request.ArtifactID,
+		"X-Registry-ArtifactType": request.ArtifactType,
+		"X-Registry-Version":      request.Version,
+		"X-Registry-Name":         request.Name,
+		"X-Registry-Description":  request.Description,
+	}
+
+	path := fmt.Sprintf("/groups/%v/artifacts", url.PathEscape(group))
+
+	return c.sendContent(ctx, http.MethodPost, path, headers, request.Content, request.References)
+}
+
+// UpdateArtifact creates a new version of an artifact whose content references other artifacts
+func (c *APIClient) UpdateArtifact(ctx context.Context, group string, artifactID string, request UpdateArtifactRequest) (*registryinstanceclient.ArtifactMetaData, *http.Response, error) {
+	headers := map[string]string{
+		"X-Registry-Version":     request.Version,
+		"X-Registry-Name":        request.Name,
+		"X-Registry-Description": request.Description,
+	}
+
+	path := fmt.Sprintf("/groups/%v/artifacts/%v", url.PathEscape(group), url.PathEscape(artifactID))
+
+	return c.sendContent(ctx, http.MethodPut, path, headers, request.Content, request.References)
+}
+
+// GetReferencesByGlobalID returns the references of an artifact version in the direction of the reference type
+func (c *APIClient) GetReferencesByGlobalID(ctx context.Context, globalID int64, refType ReferenceType) ([]ArtifactReference, *http.Response, error) {
+	path := fmt.Sprintf("/ids/globalIds/%v/references?refType=%v", globalID, refType)
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	references := []ArtifactReference{}
+	resp, err := c.do(req, &references)
+	return references, resp, err
+}
+
+func (c *APIClient) sendContent(ctx context.Context, method string, path string, headers map[string]string, content []byte, references []ArtifactReference) (*registryinstanceclient.ArtifactMetaData, *http.Response, error) {
+	if references == nil {
+		references = []ArtifactReference{}
+	}
+
+	body, err := json.Marshal(contentCreateRequest{Content: string(content), References: references})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.newRequest(ctx, method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Content-Type", extendedContentType)
+	for name, value := range headers {
+		if value != "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	var metadata registryinstanceclient.ArtifactMetaData
+	resp, err := c.do(req, &metadata)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &metadata, resp, nil
+}
+
+func (c *APIClient) newRequest(ctx context.Context, method string, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	return req, nil
+}
+
+func (c *APIClient) do(req *http.Request, v interface{}) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr.Model)
+		return resp, apiErr
+	}
+
+	return resp, json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,99 @@
+package serviceaccount
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UpdateServiceAccountRequest contains the values of a service account which can be changed.
+// The service account API replaces both values, so the unchanged value must be sent too
+type UpdateServiceAccountRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ServiceAccount is a service account returned by the service account API of the authentication server
+type ServiceAccount struct {
+	ID          string `json:"id"`
+	ClientID    string `json:"clientId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// UpdateAPI is the API definition for updating service accounts,
+// which is provided by the authentication server and not by the Kafka Management API
+type UpdateAPI interface {
+	UpdateServiceAccount(ctx context.Context, id string, request UpdateServiceAccountRequest) (*ServiceAccount, *http.Response, error)
+}
+
+// Config defines the available configuration options
+// to customize the API client settings
+type Config struct {
+	// HTTPClient is a custom HTTP client
+	HTTPClient *http.Client
+	// BaseURL is the URL of the realm of the authentication server
+	BaseURL *url.URL
+	// UserAgent sets the user agent of the requests
+	UserAgent string
+}
+
+// NewUpdateAPIClient returns a new API client
+// using a custom config
+func NewUpdateAPIClient(cfg *Config) UpdateAPI {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	return &APIClient{
+		baseURL:    cfg.BaseURL,
+		httpClient: cfg.HTTPClient,
+		userAgent:  cfg.UserAgent,
+	}
+}
+
+type APIClient struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	userAgent  string
+}
+
+// UpdateServiceAccount changes the name and description of a service account
+func (c *APIClient) UpdateServiceAccount(ctx context.Context, id string, request UpdateServiceAccountRequest) (*ServiceAccount, *http.Response, error) {
+	u := *c.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/apis/service_accounts/v1/" + url.PathEscape(id)
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, resp, errors.New(resp.Status)
+	}
+
+	var serviceAccount ServiceAccount
+	err = json.NewDecoder(resp.Body).Decode(&serviceAccount)
+	return &serviceAccount, resp, err
+}
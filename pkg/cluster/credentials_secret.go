@@ -0,0 +1,117 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/kubeclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/v1alpha"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExecuteCheckCredentialsSecret checks that the credentials of the service account can be saved to the secret,
+// so that the command can fail before the API issues credentials which cannot be retrieved again
+func (api *KubernetesClusterAPIImpl) ExecuteCheckCredentialsSecret(opts *v1alpha.SaveCredentialsSecretOperationOptions) (*v1alpha.SaveCredentialsSecretResult, error) {
+	namespace, secret, err := api.getCredentialsSecret(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1alpha.SaveCredentialsSecretResult{Namespace: namespace, Created: secret == nil}, nil
+}
+
+// ExecuteSaveCredentialsSecret creates a secret with the credentials of a service account,
+// or updates the credentials keys of the secret when it already exists
+func (api *KubernetesClusterAPIImpl) ExecuteSaveCredentialsSecret(opts *v1alpha.SaveCredentialsSecretOperationOptions) (*v1alpha.SaveCredentialsSecretResult, error) {
+	cliOpts := api.CommandEnvironment
+
+	namespace, secret, err := api.getCredentialsSecret(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1alpha.SaveCredentialsSecretResult{Namespace: namespace}
+	secretNameTmplEntry := localize.NewEntry("Name", opts.SecretName)
+
+	secrets := api.KubernetesClients.Clientset.CoreV1().Secrets(namespace)
+	if secret == nil {
+		secret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      opts.SecretName,
+				Namespace: namespace,
+			},
+			StringData: opts.Data,
+		}
+
+		if _, err = secrets.Create(cliOpts.Context, secret, metav1.CreateOptions{}); err != nil {
+			return nil, fmt.Errorf("%v: %w", cliOpts.Localizer.MustLocalize("cluster.saveCredentialsSecret.error.createError", secretNameTmplEntry), err)
+		}
+		result.Created = true
+
+		return result, nil
+	}
+
+	// keep the other keys of the secret, which can be used by applications
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for key, value := range opts.Data {
+		secret.Data[key] = []byte(value)
+	}
+
+	if _, err = secrets.Update(cliOpts.Context, secret, metav1.UpdateOptions{}); err != nil {
+		return nil, fmt.Errorf("%v: %w", cliOpts.Localizer.MustLocalize("cluster.rotateSecret.error.updateError", secretNameTmplEntry), err)
+	}
+
+	return result, nil
+}
+
+// getCredentialsSecret returns the namespace of the secret and the secret, which is nil when it does not exist.
+// It fails when the namespace does not exist, or when the secret holds the credentials of another service account
+func (api *KubernetesClusterAPIImpl) getCredentialsSecret(opts *v1alpha.SaveCredentialsSecretOperationOptions) (string, *apiv1.Secret, error) {
+	cliOpts := api.CommandEnvironment
+	kClients := api.KubernetesClients
+
+	namespace := opts.Namespace
+	if namespace == "" {
+		currentNamespace, err := kClients.CurrentNamespace()
+		if err != nil {
+			return "", nil, kubeclient.TranslatedKubernetesErrors(cliOpts, err)
+		}
+		namespace = currentNamespace
+	}
+
+	if _, err := kClients.Clientset.CoreV1().Namespaces().Get(cliOpts.Context, namespace, metav1.GetOptions{}); err != nil {
+		switch {
+		case errors.IsNotFound(err):
+			return "", nil, cliOpts.Localizer.MustLocalizeError("cluster.saveCredentialsSecret.error.namespaceNotFound", localize.NewEntry("Namespace", namespace))
+		case errors.IsForbidden(err):
+			// users can be allowed to manage secrets in a namespace without being allowed to view it
+			cliOpts.Logger.Debug(err)
+		default:
+			return "", nil, kubeclient.TranslatedKubernetesErrors(cliOpts, err)
+		}
+	}
+
+	secret, err := kClients.Clientset.CoreV1().Secrets(namespace).Get(cliOpts.Context, opts.SecretName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return namespace, nil, nil
+		}
+		return "", nil, kubeclient.TranslatedKubernetesErrors(cliOpts, err)
+	}
+
+	// the secret can hold the credentials of another service account
+	existingClientID := string(secret.Data["client-id"])
+	if !opts.Overwrite && existingClientID != "" && existingClientID != opts.ClientID {
+		return "", nil, cliOpts.Localizer.MustLocalizeError("cluster.saveCredentialsSecret.error.otherServiceAccount",
+			localize.NewEntry("Name", opts.SecretName),
+			localize.NewEntry("Namespace", namespace),
+			localize.NewEntry("ClientID", existingClientID),
+		)
+	}
+
+	return namespace, secret, nil
+}
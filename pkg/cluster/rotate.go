@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/constants"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/kubeclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/services/resources"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/v1alpha"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ExecuteRotateSecret updates the service account secret created by the connect command with new credentials
+// of the same service account, and returns the connection resources which use the secret
+func (api *KubernetesClusterAPIImpl) ExecuteRotateSecret(opts *v1alpha.RotateSecretOperationOptions) (*v1alpha.RotateSecretResult, error) {
+	cliOpts := api.CommandEnvironment
+	kClients := api.KubernetesClients
+
+	namespace := opts.Namespace
+	if namespace == "" {
+		currentNamespace, err := kClients.CurrentNamespace()
+		if err != nil {
+			return nil, kubeclient.TranslatedKubernetesErrors(cliOpts, err)
+		}
+		namespace = currentNamespace
+	}
+
+	result := &v1alpha.RotateSecretResult{Namespace: namespace}
+
+	secrets := kClients.Clientset.CoreV1().Secrets(namespace)
+	secret, err := secrets.Get(cliOpts.Context, constants.ServiceAccountSecretName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, cliOpts.Localizer.MustLocalizeError("cluster.rotateSecret.error.secretNotFound",
+				localize.NewEntry("Name", constants.ServiceAccountSecretName),
+				localize.NewEntry("Namespace", namespace),
+			)
+		}
+		return nil, kubeclient.TranslatedKubernetesErrors(cliOpts, err)
+	}
+
+	// the secret can hold the credentials of another service account
+	if string(secret.Data["client-id"]) != opts.ClientID {
+		return result, nil
+	}
+
+	secret.Data["client-secret"] = []byte(opts.ClientSecret)
+
+	if _, err = secrets.Update(cliOpts.Context, secret, metav1.UpdateOptions{}); err != nil {
+		return nil, fmt.Errorf("%v: %w", cliOpts.Localizer.MustLocalize("cluster.rotateSecret.error.updateError",
+			localize.NewEntry("Name", constants.ServiceAccountSecretName),
+		), err)
+	}
+	result.Updated = true
+
+	for _, resource := range resources.AllResources {
+		list, err := kClients.DynamicClient.Resource(resource).Namespace(namespace).List(cliOpts.Context, metav1.ListOptions{})
+		if err != nil {
+			// the custom resource definitions are not installed when the RHOAS operator is missing
+			cliOpts.Logger.Debug(err)
+			continue
+		}
+
+		for _, item := range list.Items {
+			secretName, _, _ := unstructured.NestedString(item.Object, "spec", "credentials", "serviceAccountSecretName")
+			if secretName == constants.ServiceAccountSecretName {
+				result.Consumers = append(result.Consumers, fmt.Sprintf("%v/%v", item.GetKind(), item.GetName()))
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -47,6 +47,41 @@ type CleanOperationOptions struct {
 	Namespace             string
 }
 
+// RotateSecretOperationOptions contains the new credentials of the service account secret
+type RotateSecretOperationOptions struct {
+	Namespace    string
+	ClientID     string
+	ClientSecret string
+}
+
+// RotateSecretResult describes the update of the service account secret
+type RotateSecretResult struct {
+	Namespace string
+	// Updated is false when the secret contains the credentials of another service account
+	Updated bool
+	// Consumers contains the connection resources which use the secret
+	Consumers []string
+}
+
+// SaveCredentialsSecretOperationOptions contains the secret in which to save service account credentials
+type SaveCredentialsSecretOperationOptions struct {
+	Namespace  string
+	SecretName string
+	// ClientID is the client ID of the service account, which is empty before the service account is created
+	ClientID string
+	// Overwrite allows replacing the credentials of another service account in the secret
+	Overwrite bool
+	// Data contains the keys and values of the secret
+	Data map[string]string
+}
+
+// SaveCredentialsSecretResult describes the secret in which the credentials were saved
+type SaveCredentialsSecretResult struct {
+	Namespace string
+	// Created is false when an existing secret was updated
+	Created bool
+}
+
 // status of the Operator
 type OperatorStatus struct {
 	ServiceBindingOperatorAvailable bool
@@ -60,4 +95,7 @@ type ClusterUserAPI interface {
 	ExecuteServiceBinding(options *BindOperationOptions) error
 	ExecuteStatus() (OperatorStatus, error)
 	ExecuteClean(cleanOptions *CleanOperationOptions) error
+	ExecuteRotateSecret(rotateOptions *RotateSecretOperationOptions) (*RotateSecretResult, error)
+	ExecuteCheckCredentialsSecret(checkOptions *SaveCredentialsSecretOperationOptions) (*SaveCredentialsSecretResult, error)
+	ExecuteSaveCredentialsSecret(saveOptions *SaveCredentialsSecretOperationOptions) (*SaveCredentialsSecretResult, error)
 }
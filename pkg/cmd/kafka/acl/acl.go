@@ -2,10 +2,14 @@ package acl
 
 import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/admin"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/check"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/delete"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/export"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/grant"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/revoke"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/sync"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/spf13/cobra"
 )
@@ -23,9 +27,13 @@ func NewAclCommand(f *factory.Factory) *cobra.Command {
 	cmd.AddCommand(
 		list.NewListACLCommand(f),
 		grant.NewGrantPermissionsACLCommand(f),
+		revoke.NewRevokeAccessCommand(f),
 		delete.NewDeleteCommand(f),
 		admin.NewAdminACLCommand(f),
 		create.NewCreateCommand(f),
+		export.NewExportCommand(f),
+		sync.NewSyncCommand(f),
+		check.NewCheckCommand(f),
 	)
 
 	return cmd
@@ -0,0 +1,107 @@
+package aclcmdutil
+
+import (
+	"strings"
+)
+
+// operations which imply the describe operation when they are allowed
+var describeImpliedBy = []string{OperationREAD, OperationWRITE, OperationDELETE, OperationALTER}
+
+// AccessCheck is the result of evaluating the ACL bindings for an operation of a principal on a resource
+type AccessCheck struct {
+	Principal    string `json:"principal" yaml:"principal"`
+	Operation    string `json:"operation" yaml:"operation"`
+	ResourceType string `json:"resourceType" yaml:"resourceType"`
+	ResourceName string `json:"resourceName" yaml:"resourceName"`
+	Allowed      bool   `json:"allowed" yaml:"allowed"`
+	// Bindings contains the bindings which decided the result.
+	// It is empty when the operation is denied because no binding allows it
+	Bindings []Binding `json:"bindings" yaml:"bindings"`
+}
+
+// CheckAccess evaluates the ACL bindings the way the Kafka authorizer does:
+// a matching deny binding takes precedence over any allow binding, and the operation
+// is denied when no binding allows it.
+//
+// A binding matches when its principal is the principal or the wildcard principal,
+// and its resource name is the resource name, the wildcard, or a prefix of the resource name
+// for prefixed bindings. Allowing read, write, delete or alter implies describe,
+// and allowing alter-configs implies describe-configs
+func CheckAccess(bindings []Binding, principal string, operation string, resourceType string, resourceName string) *AccessCheck {
+	check := &AccessCheck{
+		Principal:    principal,
+		Operation:    operation,
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Bindings:     []Binding{},
+	}
+
+	var allowing []Binding
+	for _, b := range bindings {
+		if !matchesPrincipal(b, principal) || !matchesResource(b, resourceType, resourceName) {
+			continue
+		}
+
+		switch b.Permission {
+		case PermissionDENY:
+			if b.Operation == operation || b.Operation == OperationALL {
+				check.Bindings = append(check.Bindings, b)
+			}
+		case PermissionALLOW:
+			if allowsOperation(b.Operation, operation) {
+				allowing = append(allowing, b)
+			}
+		}
+	}
+
+	if len(check.Bindings) > 0 {
+		sortBindings(check.Bindings)
+		return check
+	}
+
+	if len(allowing) > 0 {
+		check.Allowed = true
+		check.Bindings = allowing
+		sortBindings(check.Bindings)
+	}
+
+	return check
+}
+
+func matchesPrincipal(b Binding, principal string) bool {
+	return b.Principal == principal || b.Principal == FormatPrincipal(Wildcard)
+}
+
+func matchesResource(b Binding, resourceType string, resourceName string) bool {
+	if b.ResourceType != resourceType {
+		return false
+	}
+
+	switch b.PatternType {
+	case PatternTypeLITERAL:
+		return b.ResourceName == resourceName || b.ResourceName == Wildcard
+	case PatternTypePREFIX:
+		return strings.HasPrefix(resourceName, b.ResourceName)
+	default:
+		return false
+	}
+}
+
+func allowsOperation(allowed string, operation string) bool {
+	if allowed == operation || allowed == OperationALL {
+		return true
+	}
+
+	switch operation {
+	case OperationDESCRIBE:
+		for _, op := range describeImpliedBy {
+			if allowed == op {
+				return true
+			}
+		}
+	case OperationDESCRIBE_CONFIGS:
+		return allowed == OperationALTER_CONFIGS
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+package aclcmdutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckAccess(t *testing.T) {
+	readOrdersPrefix := newBinding("User:dev", OperationREAD, ResourceTypeTOPIC, "ord")
+	readOrdersPrefix.PatternType = PatternTypePREFIX
+	writeAll := newBinding("User:*", OperationWRITE, ResourceTypeTOPIC, "*")
+	allGroups := newBinding("User:dev", OperationALL, ResourceTypeGROUP, "*")
+	alterConfigs := newBinding("User:ops", OperationALTER_CONFIGS, ResourceTypeTOPIC, "orders")
+	denyOrders := newBinding("User:*", OperationALL, ResourceTypeTOPIC, "orders")
+	denyOrders.Permission = PermissionDENY
+	denyOrders.Principal = "User:intern"
+
+	bindings := []Binding{readOrdersPrefix, writeAll, allGroups, alterConfigs, denyOrders}
+
+	tests := []struct {
+		name         string
+		principal    string
+		operation    string
+		resourceType string
+		resourceName string
+		wantAllowed  bool
+		wantBindings []Binding
+	}{
+		{
+			name:         "Should allow an operation with a prefixed binding",
+			principal:    "User:dev",
+			operation:    OperationREAD,
+			resourceType: ResourceTypeTOPIC,
+			resourceName: "orders",
+			wantAllowed:  true,
+			wantBindings: []Binding{readOrdersPrefix},
+		},
+		{
+			name:         "Should not match a prefixed binding on another resource",
+			principal:    "User:dev",
+			operation:    OperationREAD,
+			resourceType: ResourceTypeTOPIC,
+			resourceName: "payments",
+			wantBindings: []Binding{},
+		},
+		{
+			name:         "Should allow an operation with a wildcard principal and resource",
+			principal:    "User:other",
+			operation:    OperationWRITE,
+			resourceType: ResourceTypeTOPIC,
+			resourceName: "payments",
+			wantAllowed:  true,
+			wantBindings: []Binding{writeAll},
+		},
+		{
+			name:         "Should allow describe when read or write is allowed",
+			principal:    "User:dev",
+			operation:    OperationDESCRIBE,
+			resourceType: ResourceTypeTOPIC,
+			resourceName: "orders",
+			wantAllowed:  true,
+			wantBindings: []Binding{writeAll, readOrdersPrefix},
+		},
+		{
+			name:         "Should allow describe-configs when alter-configs is allowed",
+			principal:    "User:ops",
+			operation:    OperationDESCRIBE_CONFIGS,
+			resourceType: ResourceTypeTOPIC,
+			resourceName: "orders",
+			wantAllowed:  true,
+			wantBindings: []Binding{alterConfigs},
+		},
+		{
+			name:         "Should allow any operation with the all operation",
+			principal:    "User:dev",
+			operation:    OperationDELETE,
+			resourceType: ResourceTypeGROUP,
+			resourceName: "my-group",
+			wantAllowed:  true,
+			wantBindings: []Binding{allGroups},
+		},
+		{
+			name:         "Should give precedence to deny bindings",
+			principal:    "User:intern",
+			operation:    OperationWRITE,
+			resourceType: ResourceTypeTOPIC,
+			resourceName: "orders",
+			wantBindings: []Binding{denyOrders},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			check := CheckAccess(bindings, tt.principal, tt.operation, tt.resourceType, tt.resourceName)
+			if check.Allowed != tt.wantAllowed {
+				t.Errorf("CheckAccess() allowed = %v, want %v", check.Allowed, tt.wantAllowed)
+			}
+			if !reflect.DeepEqual(check.Bindings, tt.wantBindings) {
+				t.Errorf("CheckAccess() bindings = %+v, want %+v", check.Bindings, tt.wantBindings)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package aclcmdutil
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"gopkg.in/yaml.v2"
+)
+
+// PresetPrefixPlaceholder is replaced by the value of the "--topic-prefix" flag in the resource names of presets
+const PresetPrefixPlaceholder = "{prefix}"
+
+// PresetsFileName is the name of the file in the configuration directory which defines custom ACL presets
+const PresetsFileName = "acl-presets.yaml"
+
+// Preset is a named set of ACL rules which grant the access required by a common application role
+type Preset struct {
+	Name        string          `json:"name" yaml:"name"`
+	Description string          `json:"description" yaml:"description"`
+	Rules       []PresetBinding `json:"rules" yaml:"rules"`
+}
+
+// PresetBinding allows operations on a resource. The resource name can contain the prefix placeholder
+type PresetBinding struct {
+	ResourceType string   `json:"resourceType" yaml:"resourceType"`
+	ResourceName string   `json:"resourceName" yaml:"resourceName"`
+	PatternType  string   `json:"patternType,omitempty" yaml:"patternType,omitempty"`
+	Operations   []string `json:"operations" yaml:"operations"`
+}
+
+type presetsFile struct {
+	Presets []Preset `json:"presets" yaml:"presets"`
+}
+
+var builtinPresets = []Preset{
+	{
+		Name:        "transactional-producer",
+		Description: "Produce messages to topics in transactions",
+		Rules: []PresetBinding{
+			{ResourceType: ResourceTypeTOPIC, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{OperationDESCRIBE, OperationWRITE}},
+			{ResourceType: ResourceTypeTRANSACTIONAL_ID, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{OperationDESCRIBE, OperationWRITE}},
+		},
+	},
+	{
+		Name:        "streams-app",
+		Description: "Run a Kafka Streams application whose application ID is the prefix",
+		Rules: []PresetBinding{
+			{ResourceType: ResourceTypeTOPIC, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{
+				OperationCREATE, OperationDELETE, OperationDESCRIBE, OperationDESCRIBE_CONFIGS, OperationREAD, OperationWRITE,
+			}},
+			{ResourceType: ResourceTypeGROUP, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{OperationDESCRIBE, OperationREAD}},
+			{ResourceType: ResourceTypeTRANSACTIONAL_ID, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{OperationDESCRIBE, OperationWRITE}},
+		},
+	},
+	{
+		Name:        "connect-worker",
+		Description: "Run a Kafka Connect worker whose internal topics and group ID start with the prefix",
+		Rules: []PresetBinding{
+			{ResourceType: ResourceTypeTOPIC, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{
+				OperationCREATE, OperationDESCRIBE, OperationDESCRIBE_CONFIGS, OperationREAD, OperationWRITE,
+			}},
+			{ResourceType: ResourceTypeGROUP, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{OperationDESCRIBE, OperationREAD}},
+			{ResourceType: ResourceTypeCLUSTER, ResourceName: KafkaCluster, PatternType: PatternTypeLITERAL, Operations: []string{OperationDESCRIBE}},
+		},
+	},
+	{
+		Name:        "read-only-observer",
+		Description: "View topics, their configuration and consumer groups, without producing or consuming messages",
+		Rules: []PresetBinding{
+			{ResourceType: ResourceTypeTOPIC, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{OperationDESCRIBE, OperationDESCRIBE_CONFIGS}},
+			{ResourceType: ResourceTypeGROUP, ResourceName: PresetPrefixPlaceholder, PatternType: PatternTypePREFIX, Operations: []string{OperationDESCRIBE}},
+			{ResourceType: ResourceTypeCLUSTER, ResourceName: KafkaCluster, PatternType: PatternTypeLITERAL, Operations: []string{OperationDESCRIBE}},
+		},
+	},
+}
+
+// PresetsFilePath returns the path of the file which defines custom ACL presets
+func PresetsFilePath() (string, error) {
+	dir, err := config.DefaultDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, PresetsFileName), nil
+}
+
+// LoadPresets returns the built-in presets and the presets defined in the file, sorted by name.
+// Presets in the file replace the built-in presets with the same name.
+// A missing file is not an error
+func LoadPresets(path string) ([]Preset, error) {
+	presets := map[string]Preset{}
+	for _, p := range builtinPresets {
+		presets[p.Name] = p
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err == nil {
+		var file presetsFile
+		if err = yaml.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("invalid ACL presets file %v: %w", path, err)
+		}
+		for _, p := range file.Presets {
+			if err = validatePreset(p); err != nil {
+				return nil, fmt.Errorf("invalid ACL presets file %v: %w", path, err)
+			}
+			presets[p.Name] = p
+		}
+	}
+
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sorted := make([]Preset, len(names))
+	for i, name := range names {
+		sorted[i] = presets[name]
+	}
+
+	return sorted, nil
+}
+
+// FindPreset returns the preset with the name
+func FindPreset(presets []Preset, name string) (*Preset, bool) {
+	for i := range presets {
+		if presets[i].Name == name {
+			return &presets[i], true
+		}
+	}
+	return nil, false
+}
+
+// GetPreset loads the presets and returns the preset with the name
+func GetPreset(name string, localizer localize.Localizer) (*Preset, error) {
+	path, err := PresetsFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	presets, err := LoadPresets(path)
+	if err != nil {
+		return nil, err
+	}
+
+	preset, ok := FindPreset(presets, name)
+	if !ok {
+		return nil, localizer.MustLocalizeError("kafka.acl.common.error.presetNotFound",
+			localize.NewEntry("Name", name),
+			localize.NewEntry("ValidPresets", cmdutil.StringSliceToListStringWithQuotes(PresetNames(presets))),
+			localize.NewEntry("FilePath", path),
+		)
+	}
+
+	return preset, nil
+}
+
+// PresetNames returns the names of the presets
+func PresetNames(presets []Preset) []string {
+	names := make([]string, len(presets))
+	for i, p := range presets {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// RequiresPrefix checks if the resource names of the preset contain the prefix placeholder
+func (p *Preset) RequiresPrefix() bool {
+	for _, r := range p.Rules {
+		if strings.Contains(r.ResourceName, PresetPrefixPlaceholder) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bindings creates the bindings which allow the operations of the preset to the principal
+func (p *Preset) Bindings(principal string, prefix string) []Binding {
+	var bindings []Binding
+	for _, r := range p.Rules {
+		patternType := r.PatternType
+		if patternType == "" {
+			patternType = PatternTypeLITERAL
+		}
+		for _, op := range r.Operations {
+			bindings = append(bindings, Binding{
+				Principal:    FormatPrincipal(principal),
+				Permission:   PermissionALLOW,
+				Operation:    op,
+				ResourceType: r.ResourceType,
+				ResourceName: strings.ReplaceAll(r.ResourceName, PresetPrefixPlaceholder, prefix),
+				PatternType:  patternType,
+			})
+		}
+	}
+	return bindings
+}
+
+// SharedPresetBindings returns the bindings of the preset granted with the prefix which are also required
+// by the other presets granted to the principal, and the names of these presets.
+// A preset is granted when all its bindings exist in the current bindings of the principal
+func SharedPresetBindings(presets []Preset, preset *Preset, principal string, prefix string, current []Binding) ([]Binding, []string) {
+	existing := map[Binding]bool{}
+	for _, b := range current {
+		existing[b] = true
+	}
+
+	revoked := map[Binding]bool{}
+	for _, b := range preset.Bindings(principal, prefix) {
+		revoked[b] = true
+	}
+
+	shared := map[Binding]bool{}
+	grantedBy := map[string]bool{}
+	for i := range presets {
+		p := &presets[i]
+		for _, candidate := range p.grantedPrefixes(current) {
+			if p.Name == preset.Name && candidate == prefix {
+				continue
+			}
+
+			bindings := p.Bindings(principal, candidate)
+			if !allExist(bindings, existing) {
+				continue
+			}
+
+			for _, b := range bindings {
+				if revoked[b] {
+					shared[b] = true
+					grantedBy[p.Name] = true
+				}
+			}
+		}
+	}
+
+	sharedBindings := make([]Binding, 0, len(shared))
+	for b := range shared {
+		sharedBindings = append(sharedBindings, b)
+	}
+	sortBindings(sharedBindings)
+
+	names := make([]string, 0, len(grantedBy))
+	for name := range grantedBy {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return sharedBindings, names
+}
+
+// grantedPrefixes returns the prefixes with which the preset may be granted,
+// derived from the resource names of the bindings which match the rules of the preset
+func (p *Preset) grantedPrefixes(bindings []Binding) []string {
+	if !p.RequiresPrefix() {
+		return []string{""}
+	}
+
+	seen := map[string]bool{}
+	var prefixes []string
+	for _, r := range p.Rules {
+		i := strings.Index(r.ResourceName, PresetPrefixPlaceholder)
+		if i < 0 {
+			continue
+		}
+		before, after := r.ResourceName[:i], r.ResourceName[i+len(PresetPrefixPlaceholder):]
+
+		for _, b := range bindings {
+			name := b.ResourceName
+			if b.ResourceType != r.ResourceType || len(name) <= len(before)+len(after) ||
+				!strings.HasPrefix(name, before) || !strings.HasSuffix(name, after) {
+				continue
+			}
+
+			prefix := name[len(before) : len(name)-len(after)]
+			if !seen[prefix] {
+				seen[prefix] = true
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+
+	return prefixes
+}
+
+func allExist(bindings []Binding, existing map[Binding]bool) bool {
+	for _, b := range bindings {
+		if !existing[b] {
+			return false
+		}
+	}
+	return len(bindings) > 0
+}
+
+func validatePreset(p Preset) error {
+	if p.Name == "" {
+		return fmt.Errorf("a preset has no name")
+	}
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("preset %q has no rules", p.Name)
+	}
+
+	for _, r := range p.Rules {
+		if _, ok := resourceTypeMap[r.ResourceType]; !ok {
+			return fmt.Errorf("preset %q: invalid resource type %q", p.Name, r.ResourceType)
+		}
+		if r.ResourceName == "" {
+			return fmt.Errorf("preset %q: a rule has no resource name", p.Name)
+		}
+		if _, ok := patternTypeMap[r.PatternType]; r.PatternType != "" && !ok {
+			return fmt.Errorf("preset %q: invalid pattern type %q", p.Name, r.PatternType)
+		}
+		if len(r.Operations) == 0 {
+			return fmt.Errorf("preset %q: a rule has no operations", p.Name)
+		}
+		for _, op := range r.Operations {
+			if _, ok := operationMap[op]; !ok {
+				return fmt.Errorf("preset %q: invalid operation %q", p.Name, op)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package aclcmdutil
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadPresets(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"custom.yaml": `presets:
+- name: streams-app
+  description: Custom streams application
+  rules:
+  - resourceType: topic
+    resourceName: "{prefix}"
+    patternType: prefix
+    operations: [read]
+- name: audit-reader
+  rules:
+  - resourceType: topic
+    resourceName: audit
+    operations: [read, describe]
+`,
+		"operation.yaml": "presets:\n- name: invalid\n  rules:\n  - {resourceType: topic, resourceName: audit, operations: [consume]}\n",
+		"resource.yaml":  "presets:\n- name: invalid\n  rules:\n  - {resourceType: any, resourceName: audit, operations: [read]}\n",
+		"rules.yaml":     "presets:\n- name: invalid\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		file      string
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "Should return the built-in presets when the file does not exist",
+			file:      "missing.yaml",
+			wantNames: []string{"connect-worker", "read-only-observer", "streams-app", "transactional-producer"},
+		},
+		{
+			name:      "Should add and replace presets defined in the file",
+			file:      "custom.yaml",
+			wantNames: []string{"audit-reader", "connect-worker", "read-only-observer", "streams-app", "transactional-producer"},
+		},
+		{name: "Should fail for an invalid operation", file: "operation.yaml", wantErr: true},
+		{name: "Should fail for an invalid resource type", file: "resource.yaml", wantErr: true},
+		{name: "Should fail for a preset without rules", file: "rules.yaml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			presets, err := LoadPresets(filepath.Join(dir, tt.file))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadPresets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(PresetNames(presets), tt.wantNames) {
+				t.Errorf("LoadPresets() names = %v, want %v", PresetNames(presets), tt.wantNames)
+			}
+		})
+	}
+
+	presets, _ := LoadPresets(filepath.Join(dir, "custom.yaml"))
+	if p, _ := FindPreset(presets, "streams-app"); p.Description != "Custom streams application" {
+		t.Errorf("LoadPresets() did not replace the built-in preset: %+v", p)
+	}
+}
+
+func TestPresetBindings(t *testing.T) {
+	presets, err := LoadPresets(filepath.Join(t.TempDir(), PresetsFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	preset, ok := FindPreset(presets, "transactional-producer")
+	if !ok || !preset.RequiresPrefix() {
+		t.Fatalf("FindPreset() = %+v, want the transactional-producer preset requiring a prefix", preset)
+	}
+
+	topicDescribe := newBinding("User:dev", OperationDESCRIBE, ResourceTypeTOPIC, "orders-")
+	topicDescribe.PatternType = PatternTypePREFIX
+	topicWrite := topicDescribe
+	topicWrite.Operation = OperationWRITE
+	txDescribe := topicDescribe
+	txDescribe.ResourceType = ResourceTypeTRANSACTIONAL_ID
+	txWrite := txDescribe
+	txWrite.Operation = OperationWRITE
+
+	want := []Binding{topicDescribe, topicWrite, txDescribe, txWrite}
+	if got := preset.Bindings("dev", "orders-"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Bindings() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSharedPresetBindings(t *testing.T) {
+	presets, err := LoadPresets(filepath.Join(t.TempDir(), PresetsFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	observer, _ := FindPreset(presets, "read-only-observer")
+	connect, _ := FindPreset(presets, "connect-worker")
+
+	clusterDescribe := newBinding("User:dev", OperationDESCRIBE, ResourceTypeCLUSTER, KafkaCluster)
+	// the connect-worker bindings which allow reading and writing, without the describe operations
+	var connectReadWrite []Binding
+	for _, b := range connect.Bindings("dev", "connect-") {
+		if b.Operation == OperationREAD || b.Operation == OperationWRITE {
+			connectReadWrite = append(connectReadWrite, b)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		current   []Binding
+		want      []Binding
+		wantNames []string
+	}{
+		{
+			name:    "Should not share bindings when no other preset is granted",
+			current: observer.Bindings("dev", "orders-"),
+			want:    []Binding{},
+		},
+		{
+			// the bindings of the connect-worker preset include the read-only-observer bindings for the same prefix
+			name:      "Should share the bindings required by another granted preset",
+			current:   append(observer.Bindings("dev", "orders-"), connect.Bindings("dev", "connect-")...),
+			want:      []Binding{clusterDescribe},
+			wantNames: []string{"connect-worker", "read-only-observer"},
+		},
+		{
+			name:      "Should share the bindings required by the same preset granted with another prefix",
+			current:   append(observer.Bindings("dev", "orders-"), observer.Bindings("dev", "audit-")...),
+			want:      []Binding{clusterDescribe},
+			wantNames: []string{"read-only-observer"},
+		},
+		{
+			name:    "Should not share bindings with a partially granted preset",
+			current: append(observer.Bindings("dev", "orders-"), connectReadWrite...),
+			want:    []Binding{},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			got, names := SharedPresetBindings(presets, observer, "dev", "orders-", tt.current)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SharedPresetBindings() bindings = %+v, want %+v", got, tt.want)
+			}
+			if len(names) != len(tt.wantNames) || (len(names) > 0 && !reflect.DeepEqual(names, tt.wantNames)) {
+				t.Errorf("SharedPresetBindings() presets = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
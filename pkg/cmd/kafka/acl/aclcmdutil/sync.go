@@ -0,0 +1,290 @@
+package aclcmdutil
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"gopkg.in/yaml.v2"
+)
+
+const listPageSize = 100
+
+// Actions of an ACL sync plan
+const (
+	SyncActionCreate = "create"
+	SyncActionDelete = "delete"
+)
+
+// Binding is the declarative representation of an ACL binding,
+// which uses the same values as the flags of the ACL commands
+type Binding struct {
+	Principal    string `json:"principal" yaml:"principal"`
+	Permission   string `json:"permission" yaml:"permission"`
+	Operation    string `json:"operation" yaml:"operation"`
+	ResourceType string `json:"resourceType" yaml:"resourceType"`
+	ResourceName string `json:"resourceName" yaml:"resourceName"`
+	PatternType  string `json:"patternType" yaml:"patternType"`
+}
+
+// BindingsFile contains the ACL bindings of a Kafka instance
+type BindingsFile struct {
+	Bindings []Binding `json:"bindings" yaml:"bindings"`
+}
+
+// SyncChange is a binding which is created or deleted by an ACL sync
+type SyncChange struct {
+	Action  string
+	Binding Binding
+}
+
+type syncChangeRow struct {
+	Action      string `header:"Action"`
+	Principal   string `header:"Principal"`
+	Permission  string `header:"Permission"`
+	Operation   string `header:"Operation"`
+	Description string `header:"Description"`
+}
+
+// ListAllACLs fetches all ACL bindings of a Kafka instance
+func ListAllACLs(ctx context.Context, api *kafkainstanceclient.APIClient) ([]kafkainstanceclient.AclBinding, *http.Response, error) {
+	var bindings []kafkainstanceclient.AclBinding
+	for page := 1; ; page++ {
+		req := api.AclsApi.GetAcls(ctx).Page(float32(page)).Size(listPageSize).Order("asc").OrderKey("principal")
+
+		aclData, httpRes, err := req.Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, httpRes, err
+		}
+
+		items := aclData.GetItems()
+		bindings = append(bindings, items...)
+
+		if len(items) == 0 || len(bindings) >= int(aclData.GetTotal()) {
+			return bindings, httpRes, nil
+		}
+	}
+}
+
+// NewBindingsFile converts ACL bindings into their declarative representation, sorted by principal and resource
+func NewBindingsFile(bindings []kafkainstanceclient.AclBinding) *BindingsFile {
+	file := &BindingsFile{Bindings: make([]Binding, len(bindings))}
+	for i, b := range bindings {
+		file.Bindings[i] = NewBinding(b)
+	}
+
+	sortBindings(file.Bindings)
+
+	return file
+}
+
+// NewBinding converts an ACL binding into its declarative representation
+func NewBinding(binding kafkainstanceclient.AclBinding) Binding {
+	return Binding{
+		Principal:    binding.GetPrincipal(),
+		Permission:   permissionName(binding.GetPermission()),
+		Operation:    operationName(binding.GetOperation()),
+		ResourceType: resourceTypeName(binding.GetResourceType()),
+		ResourceName: binding.GetResourceName(),
+		PatternType:  patternTypeName(binding.GetPatternType()),
+	}
+}
+
+// ReadBindingsFile reads the ACL bindings from a JSON or YAML file.
+// Principals without a type, such as "my-user", are treated as users,
+// and the "all" alias is replaced by the wildcard in principals and resource names
+func ReadBindingsFile(path string) (*BindingsFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file BindingsFile
+	if err = yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid ACL file %v: %w", path, err)
+	}
+
+	seen := map[Binding]bool{}
+	bindings := make([]Binding, 0, len(file.Bindings))
+	for i, b := range file.Bindings {
+		if b.Principal == "" || b.ResourceName == "" {
+			return nil, fmt.Errorf("invalid ACL file %v: binding %v has no principal or resource name", path, i+1)
+		}
+		b.ResourceName = GetResourceName(b.ResourceName)
+		if !strings.Contains(b.Principal, ":") {
+			b.Principal = FormatPrincipal(GetResourceName(b.Principal))
+		}
+		if b.PatternType == "" {
+			b.PatternType = PatternTypeLITERAL
+		}
+		if _, err = b.ToAclBinding(); err != nil {
+			return nil, fmt.Errorf("invalid ACL file %v: binding %v: %w", path, i+1, err)
+		}
+
+		if seen[b] {
+			continue
+		}
+		seen[b] = true
+		bindings = append(bindings, b)
+	}
+	file.Bindings = bindings
+
+	return &file, nil
+}
+
+// ToAclBinding converts the declarative representation into an ACL binding
+func (b Binding) ToAclBinding() (*kafkainstanceclient.AclBinding, error) {
+	resourceType, ok := resourceTypeMap[b.ResourceType]
+	if !ok {
+		return nil, fmt.Errorf("invalid resource type %q", b.ResourceType)
+	}
+	patternType, ok := patternTypeMap[b.PatternType]
+	if !ok {
+		return nil, fmt.Errorf("invalid pattern type %q", b.PatternType)
+	}
+	operation, ok := operationMap[b.Operation]
+	if !ok {
+		return nil, fmt.Errorf("invalid operation %q", b.Operation)
+	}
+	permission, ok := permissionTypeMap[b.Permission]
+	if !ok {
+		return nil, fmt.Errorf("invalid permission %q", b.Permission)
+	}
+
+	return kafkainstanceclient.NewAclBinding(resourceType, b.ResourceName, patternType, b.Principal, operation, permission), nil
+}
+
+// PlanSync computes the bindings to create so that all desired bindings exist.
+// When prune is set, the current bindings which are not desired are deleted
+func PlanSync(current []Binding, desired []Binding, prune bool) []SyncChange {
+	existing := map[Binding]bool{}
+	for _, b := range current {
+		existing[b] = true
+	}
+
+	wanted := map[Binding]bool{}
+	for _, b := range desired {
+		wanted[b] = true
+	}
+
+	var changes []SyncChange
+	for _, b := range desired {
+		if !existing[b] {
+			changes = append(changes, SyncChange{Action: SyncActionCreate, Binding: b})
+		}
+	}
+
+	if prune {
+		for _, b := range current {
+			// bindings with values unknown to the CLI can not be deleted precisely
+			if _, err := b.ToAclBinding(); err != nil {
+				continue
+			}
+			if !wanted[b] {
+				changes = append(changes, SyncChange{Action: SyncActionDelete, Binding: b})
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Action != changes[j].Action {
+			return changes[i].Action == SyncActionCreate
+		}
+		return lessBinding(changes[i].Binding, changes[j].Binding)
+	})
+
+	return changes
+}
+
+// DeleteRequest creates a request which deletes exactly the binding
+func (b Binding) DeleteRequest(ctx context.Context, api *kafkainstanceclient.APIClient) kafkainstanceclient.ApiDeleteAclsRequest {
+	return api.AclsApi.DeleteAcls(ctx).
+		ResourceType(resourceTypeFilterMap[b.ResourceType]).
+		ResourceName(b.ResourceName).
+		PatternType(patternTypeFilterMap[b.PatternType]).
+		Principal(b.Principal).
+		Operation(operationFilterMap[b.Operation]).
+		Permission(permissionTypeFilterMap[b.Permission])
+}
+
+// MapSyncChangesToTableRows converts the changes of an ACL sync into a formatted table for printing
+func MapSyncChangesToTableRows(changes []SyncChange, localizer localize.Localizer) []syncChangeRow {
+	rows := make([]syncChangeRow, len(changes))
+	for i, c := range changes {
+		binding, err := c.Binding.ToAclBinding()
+		if err != nil {
+			continue
+		}
+		row := MapACLsToTableRows([]kafkainstanceclient.AclBinding{*binding}, localizer)[0]
+		rows[i] = syncChangeRow{
+			Action:      c.Action,
+			Principal:   row.Principal,
+			Permission:  row.Permission,
+			Operation:   row.Operation,
+			Description: row.Description,
+		}
+	}
+
+	return rows
+}
+
+func permissionName(permission kafkainstanceclient.AclPermissionType) string {
+	for k, v := range permissionTypeMap {
+		if v == permission {
+			return k
+		}
+	}
+	return string(permission)
+}
+
+func operationName(operation kafkainstanceclient.AclOperation) string {
+	for k, v := range operationMap {
+		if v == operation {
+			return k
+		}
+	}
+	return string(operation)
+}
+
+func resourceTypeName(resourceType kafkainstanceclient.AclResourceType) string {
+	for k, v := range resourceTypeMap {
+		if v == resourceType {
+			return k
+		}
+	}
+	return string(resourceType)
+}
+
+func patternTypeName(patternType kafkainstanceclient.AclPatternType) string {
+	for k, v := range patternTypeMap {
+		if v == patternType {
+			return k
+		}
+	}
+	return string(patternType)
+}
+
+func sortBindings(bindings []Binding) {
+	sort.Slice(bindings, func(i, j int) bool {
+		return lessBinding(bindings[i], bindings[j])
+	})
+}
+
+func lessBinding(a Binding, b Binding) bool {
+	keyA := []string{a.Principal, a.ResourceType, a.ResourceName, a.PatternType, a.Operation, a.Permission}
+	keyB := []string{b.Principal, b.ResourceType, b.ResourceName, b.PatternType, b.Operation, b.Permission}
+	for i := range keyA {
+		if keyA[i] != keyB[i] {
+			return keyA[i] < keyB[i]
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package aclcmdutil
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func newBinding(principal string, operation string, resourceType string, resourceName string) Binding {
+	return Binding{
+		Principal:    principal,
+		Permission:   PermissionALLOW,
+		Operation:    operation,
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		PatternType:  PatternTypeLITERAL,
+	}
+}
+
+func TestNewBindingsFile(t *testing.T) {
+	bindings := []kafkainstanceclient.AclBinding{
+		*kafkainstanceclient.NewAclBinding(kafkainstanceclient.ACLRESOURCETYPE_TOPIC, "orders", kafkainstanceclient.ACLPATTERNTYPE_PREFIXED,
+			"User:dev", kafkainstanceclient.ACLOPERATION_DESCRIBE_CONFIGS, kafkainstanceclient.ACLPERMISSIONTYPE_DENY),
+		*kafkainstanceclient.NewAclBinding(kafkainstanceclient.ACLRESOURCETYPE_TRANSACTIONAL_ID, "tx", kafkainstanceclient.ACLPATTERNTYPE_LITERAL,
+			"User:*", kafkainstanceclient.ACLOPERATION_WRITE, kafkainstanceclient.ACLPERMISSIONTYPE_ALLOW),
+	}
+
+	want := []Binding{
+		newBinding("User:*", OperationWRITE, ResourceTypeTRANSACTIONAL_ID, "tx"),
+		{
+			Principal:    "User:dev",
+			Permission:   PermissionDENY,
+			Operation:    OperationDESCRIBE_CONFIGS,
+			ResourceType: ResourceTypeTOPIC,
+			ResourceName: "orders",
+			PatternType:  PatternTypePREFIX,
+		},
+	}
+
+	if got := NewBindingsFile(bindings).Bindings; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewBindingsFile() = %+v, want %+v", got, want)
+	}
+}
+
+func TestReadBindingsFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"acls.yaml": `bindings:
+- principal: dev
+  permission: allow
+  operation: read
+  resourceType: topic
+  resourceName: orders
+- principal: User:dev
+  permission: allow
+  operation: read
+  resourceType: topic
+  resourceName: orders
+  patternType: literal
+`,
+		"acls.json":        `{"bindings": [{"principal": "all", "permission": "allow", "operation": "read", "resourceType": "topic", "resourceName": "orders"}]}`,
+		"wildcard.yaml":    "bindings:\n- {principal: dev, permission: allow, operation: describe, resourceType: topic, resourceName: all}\n",
+		"operation.yaml":   "bindings:\n- {principal: dev, permission: allow, operation: produce, resourceType: topic, resourceName: orders}\n",
+		"resource.yaml":    "bindings:\n- {principal: dev, permission: allow, operation: read, resourceType: any, resourceName: orders}\n",
+		"name.yaml":        "bindings:\n- {principal: dev, permission: allow, operation: read, resourceType: topic}\n",
+		"permission.yaml":  "bindings:\n- {principal: dev, permission: any, operation: read, resourceType: topic, resourceName: orders}\n",
+		"patternType.yaml": "bindings:\n- {principal: dev, permission: allow, operation: read, resourceType: topic, resourceName: orders, patternType: match}\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    []Binding
+		wantErr bool
+	}{
+		{
+			name: "Should read bindings in YAML format and remove duplicates",
+			file: "acls.yaml",
+			want: []Binding{newBinding("User:dev", OperationREAD, ResourceTypeTOPIC, "orders")},
+		},
+		{
+			name: "Should read bindings in JSON format with the all accounts alias",
+			file: "acls.json",
+			want: []Binding{newBinding("User:*", OperationREAD, ResourceTypeTOPIC, "orders")},
+		},
+		{
+			name: "Should replace the all alias in resource names",
+			file: "wildcard.yaml",
+			want: []Binding{newBinding("User:dev", OperationDESCRIBE, ResourceTypeTOPIC, "*")},
+		},
+		{name: "Should fail for an invalid operation", file: "operation.yaml", wantErr: true},
+		{name: "Should fail for an invalid resource type", file: "resource.yaml", wantErr: true},
+		{name: "Should fail for a missing resource name", file: "name.yaml", wantErr: true},
+		{name: "Should fail for an invalid permission", file: "permission.yaml", wantErr: true},
+		{name: "Should fail for an invalid pattern type", file: "patternType.yaml", wantErr: true},
+		{name: "Should fail for a missing file", file: "missing.yaml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := ReadBindingsFile(filepath.Join(dir, tt.file))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadBindingsFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(file.Bindings, tt.want) {
+				t.Errorf("ReadBindingsFile() = %+v, want %+v", file.Bindings, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanSync(t *testing.T) {
+	readOrders := newBinding("User:dev", OperationREAD, ResourceTypeTOPIC, "orders")
+	writeOrders := newBinding("User:dev", OperationWRITE, ResourceTypeTOPIC, "orders")
+	describeAll := newBinding("User:*", OperationDESCRIBE, ResourceTypeTOPIC, "*")
+	readGroup := newBinding("User:dev", OperationREAD, ResourceTypeGROUP, "app")
+
+	current := []Binding{describeAll, readOrders, writeOrders}
+	desired := []Binding{readGroup, readOrders}
+
+	tests := []struct {
+		name  string
+		prune bool
+		want  []SyncChange
+	}{
+		{
+			name: "Should only create missing bindings",
+			want: []SyncChange{{Action: SyncActionCreate, Binding: readGroup}},
+		},
+		{
+			name:  "Should delete undesired bindings when pruning",
+			prune: true,
+			want: []SyncChange{
+				{Action: SyncActionCreate, Binding: readGroup},
+				{Action: SyncActionDelete, Binding: describeAll},
+				{Action: SyncActionDelete, Binding: writeOrders},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlanSync(current, desired, tt.prune); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlanSync() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if got := PlanSync(current, current, true); len(got) != 0 {
+		t.Errorf("PlanSync() = %+v, want no changes", got)
+	}
+}
@@ -63,6 +63,16 @@ func FormatPrincipal(userID string) string {
 	return fmt.Sprintf("User:%s", userID)
 }
 
+// PrincipalDeleteRequest creates a request which deletes all ACL bindings of the principal
+func PrincipalDeleteRequest(ctx context.Context, api *kafkainstanceclient.APIClient, principal string) kafkainstanceclient.ApiDeleteAclsRequest {
+	return api.AclsApi.DeleteAcls(ctx).
+		ResourceType(GetMappedResourceTypeFilterValue(ResourceTypeANY)).
+		PatternType(GetMappedPatternTypeFilterValue(PatternTypeANY)).
+		Principal(FormatPrincipal(principal)).
+		Operation(kafkainstanceclient.ACLOPERATIONFILTER_ANY).
+		Permission(GetMappedPermissionTypeFilterValue(PermissionANY))
+}
+
 // GetResourceName returns the name of the resource
 // transformed into a server recognized format
 func GetResourceName(resourceName string) string {
@@ -160,3 +170,31 @@ func BuildInstructions(errorCollection []error) error {
 
 	return errors.New(errString)
 }
+
+// ValidatePrincipalFlags checks that exactly one principal is selected
+// by the "--user", "--service-account" and "--all-accounts" flags
+func ValidatePrincipalFlags(localizer localize.Localizer, userID string, serviceAccount string, allAccounts bool) []error {
+	var errorCollection []error
+
+	// check if principal is provided
+	if userID == "" && serviceAccount == "" && !allAccounts {
+		errorCollection = append(errorCollection, localizer.MustLocalizeError("kafka.acl.common.error.noPrincipalsSelected"))
+	}
+
+	// user and service account should not be provided together
+	if userID != "" && serviceAccount != "" {
+		errorCollection = append(errorCollection, localizer.MustLocalizeError("kafka.acl.common.error.bothPrincipalsSelected"))
+	}
+
+	// user and service account can't be along with "--all-accounts" flag
+	if allAccounts && (serviceAccount != "" || userID != "") {
+		errorCollection = append(errorCollection, localizer.MustLocalizeError("kafka.acl.common.error.allAccountsCannotBeUsedWithUserFlag"))
+	}
+
+	// user and service account should not allow wildcard
+	if userID == Wildcard || serviceAccount == Wildcard || userID == AllAlias || serviceAccount == AllAlias {
+		errorCollection = append(errorCollection, localizer.MustLocalizeError("kafka.acl.common.error.useAllAccountsFlag"))
+	}
+
+	return errorCollection
+}
@@ -0,0 +1,205 @@
+package check
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	aclFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/flagutil"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serviceAccount string
+	userID         string
+)
+
+// NewCheckCommand creates a new command to check whether a principal is allowed to perform an operation
+func NewCheckCommand(f *factory.Factory) *cobra.Command {
+	opts := &aclcmdutil.CrudOptions{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		Localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "check",
+		Short:   f.Localizer.MustLocalize("kafka.acl.check.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.acl.check.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.acl.check.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.Output != "" {
+				if err := flagutil.ValidateOutput(opts.Output); err != nil {
+					return err
+				}
+			}
+
+			var errorCollection []error
+
+			if opts.Operation == "" {
+				errorCollection = append(errorCollection, opts.Localizer.MustLocalizeError("kafka.acl.common.flag.operation.required"))
+			}
+
+			if resourceErrors := aclcmdutil.ValidateAndSetResources(opts, aclFlagutil.ResourceTypeFlagEntries); resourceErrors != nil {
+				errorCollection = append(errorCollection, resourceErrors)
+			}
+
+			if principalErrors := validateAndSetOpts(opts); principalErrors != nil {
+				errorCollection = append(errorCollection, principalErrors)
+			}
+
+			if len(errorCollection) > 0 {
+				return aclcmdutil.BuildInstructions(errorCollection)
+			}
+
+			return runCheck(opts)
+		},
+	}
+
+	flags := aclFlagutil.NewFlagSet(cmd, f)
+
+	flags.AddOperationCreate(&opts.Operation)
+	flags.AddCluster(&opts.Cluster)
+	flags.AddTopic(&opts.Topic)
+	flags.AddConsumerGroup(&opts.Group)
+	flags.AddTransactionalID(&opts.TransactionalID)
+	flags.AddInstanceID(&opts.InstanceID)
+	flags.AddUser(&userID)
+	flags.AddServiceAccount(&serviceAccount)
+	flags.AddOutput(&opts.Output)
+
+	return cmd
+}
+
+// nolint:funlen
+func runCheck(opts *aclcmdutil.CrudOptions) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	kafkaName := kafkaInstance.GetName()
+
+	resourceOperations, httpRes, err := api.AclsApi.GetAclResourceOperations(opts.Context).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	if isValidOp, validResourceOperations := aclcmdutil.IsValidResourceOperation(opts.ResourceType, opts.Operation, resourceOperations); !isValidOp {
+		return opts.Localizer.MustLocalizeError("kafka.acl.common.error.invalidResourceOperation",
+			localize.NewEntry("ResourceType", opts.ResourceType),
+			localize.NewEntry("Operation", opts.Operation),
+			localize.NewEntry("ValidOperationList", cmdutil.StringSliceToListStringWithQuotes(validResourceOperations)),
+		)
+	}
+
+	bindings, httpRes, err := aclcmdutil.ListAllACLs(opts.Context, api)
+	if err = aclcmdutil.ValidateAPIError(httpRes, opts.Localizer, err, "list", kafkaName); err != nil {
+		return err
+	}
+
+	check := aclcmdutil.CheckAccess(
+		aclcmdutil.NewBindingsFile(bindings).Bindings,
+		aclcmdutil.FormatPrincipal(opts.Principal),
+		opts.Operation,
+		opts.ResourceType,
+		opts.ResourceName,
+	)
+
+	if opts.Output != "" {
+		return dump.Formatted(opts.IO.Out, opts.Output, check)
+	}
+
+	tmplEntries := []*localize.TemplateEntry{
+		localize.NewEntry("Account", opts.Principal),
+		localize.NewEntry("Operation", opts.Operation),
+		localize.NewEntry("ResourceType", opts.ResourceType),
+		localize.NewEntry("ResourceName", opts.ResourceName),
+		localize.NewEntry("InstanceName", kafkaName),
+	}
+
+	if check.Allowed {
+		opts.Logger.Info(icon.SuccessPrefix(), opts.Localizer.MustLocalize("kafka.acl.check.log.info.allowed", tmplEntries...))
+	} else {
+		opts.Logger.Info(icon.ErrorPrefix(), opts.Localizer.MustLocalize("kafka.acl.check.log.info.denied", tmplEntries...))
+	}
+
+	if len(check.Bindings) == 0 {
+		opts.Logger.Info(opts.Localizer.MustLocalize("kafka.acl.check.log.info.noMatchingACLs"))
+		return nil
+	}
+
+	decidingBindings := make([]kafkainstanceclient.AclBinding, 0, len(check.Bindings))
+	for _, b := range check.Bindings {
+		binding, err := b.ToAclBinding()
+		if err != nil {
+			return err
+		}
+		decidingBindings = append(decidingBindings, *binding)
+	}
+
+	opts.Logger.Info()
+	opts.Logger.Info(opts.Localizer.MustLocalizePlural("kafka.acl.check.log.info.decidingACLs", len(decidingBindings)))
+	opts.Logger.Info()
+	dump.Table(opts.IO.Out, aclcmdutil.MapACLsToTableRows(decidingBindings, opts.Localizer))
+	opts.Logger.Info()
+
+	return nil
+}
+
+func validateAndSetOpts(opts *aclcmdutil.CrudOptions) error {
+	// user and service account should not be provided together
+	if userID != "" && serviceAccount != "" {
+		return opts.Localizer.MustLocalizeError("kafka.acl.common.error.bothPrincipalsSelected")
+	}
+
+	if userID == "" && serviceAccount == "" {
+		return opts.Localizer.MustLocalizeError("kafka.acl.check.error.noPrincipalSelected")
+	}
+
+	// access is always checked for a specific account
+	if userID == aclcmdutil.Wildcard || serviceAccount == aclcmdutil.Wildcard || userID == aclcmdutil.AllAlias || serviceAccount == aclcmdutil.AllAlias {
+		return opts.Localizer.MustLocalizeError("kafka.acl.check.error.wildcardPrincipal")
+	}
+
+	if userID != "" {
+		opts.Principal = userID
+	} else {
+		opts.Principal = serviceAccount
+	}
+
+	if opts.InstanceID == "" {
+		cfg, err := opts.Config.Load()
+		if err != nil {
+			return err
+		}
+
+		instanceID, ok := cfg.GetKafkaIdOk()
+
+		if !ok {
+			return opts.Localizer.MustLocalizeError("kafka.acl.common.error.noKafkaSelected")
+		}
+
+		opts.InstanceID = instanceID
+	}
+
+	return nil
+}
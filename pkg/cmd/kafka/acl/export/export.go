@@ -0,0 +1,127 @@
+package export
+
+import (
+	"context"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	aclFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/flagutil"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	config     config.IConfig
+	connection factory.ConnectionFunc
+	logger     logging.Logger
+	io         *iostreams.IOStreams
+	localizer  localize.Localizer
+	context    context.Context
+
+	kafkaID string
+	output  string
+	file    string
+}
+
+// NewExportCommand creates a new command to export all Kafka ACL rules
+func NewExportCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		config:     f.Config,
+		connection: f.Connection,
+		logger:     f.Logger,
+		io:         f.IOStreams,
+		localizer:  f.Localizer,
+		context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   f.Localizer.MustLocalize("kafka.acl.export.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.acl.export.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.acl.export.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := flagutil.ValidateOutput(opts.output); err != nil {
+				return err
+			}
+
+			if opts.kafkaID != "" {
+				return runExport(opts)
+			}
+
+			cfg, err := opts.config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.acl.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runExport(opts)
+		},
+	}
+
+	flags := aclFlagutil.NewFlagSet(cmd, f)
+
+	flags.AddInstanceID(&opts.kafkaID)
+	flags.StringVarP(&opts.output, "output", "o", dump.YAMLFormat, flagutil.FlagDescription(opts.localizer, "kafka.acl.export.flag.output.description", flagutil.ValidOutputFormats...))
+	flags.StringVar(&opts.file, "file", "", opts.localizer.MustLocalize("kafka.acl.export.flag.file.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runExport(opts *options) error {
+	conn, err := opts.connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	bindings, httpRes, err := aclcmdutil.ListAllACLs(opts.context, api)
+	if err = aclcmdutil.ValidateAPIError(httpRes, opts.localizer, err, "list", kafkaInstance.GetName()); err != nil {
+		return err
+	}
+
+	bindingsFile := aclcmdutil.NewBindingsFile(bindings)
+
+	if opts.file == "" {
+		return dump.Formatted(opts.io.Out, opts.output, bindingsFile)
+	}
+
+	file, err := os.Create(opts.file)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err = dump.Formatted(file, opts.output, bindingsFile); err != nil {
+		return err
+	}
+
+	opts.logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalizePlural("kafka.acl.export.log.info.exported", len(bindingsFile.Bindings),
+		localize.NewEntry("Count", len(bindingsFile.Bindings)),
+		localize.NewEntry("InstanceName", kafkaInstance.GetName()),
+		localize.NewEntry("FilePath", opts.file),
+	))
+
+	return nil
+}
@@ -281,3 +281,27 @@ func (fs *flagSet) AddAllAccounts(allAccounts *bool) {
 		fs.factory.Localizer.MustLocalize("kafka.acl.common.flag.allAccounts.description"),
 	)
 }
+
+// AddPreset adds a flag for selecting an ACL preset and registers completion options
+func (fs *flagSet) AddPreset(preset *string) {
+	flagName := "preset"
+
+	fs.StringVar(
+		preset,
+		flagName,
+		"",
+		fs.factory.Localizer.MustLocalize("kafka.acl.common.flag.preset.description"),
+	)
+
+	_ = fs.cmd.RegisterFlagCompletionFunc(flagName, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		path, err := aclcmdutil.PresetsFilePath()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		presets, err := aclcmdutil.LoadPresets(path)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return aclcmdutil.PresetNames(presets), cobra.ShellCompDirectiveNoSpace
+	})
+}
@@ -42,6 +42,7 @@ type options struct {
 	consumer    bool
 	topicPrefix string
 	groupPrefix string
+	preset      string
 	force       bool
 }
 
@@ -65,8 +66,14 @@ func NewGrantPermissionsACLCommand(f *factory.Factory) *cobra.Command {
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
+			if opts.preset != "" {
+				if err := validatePresetFlagInputCombination(opts); err != nil {
+					return err
+				}
+			}
+
 			if opts.kafkaID != "" {
-				return runGrantPermissions(opts)
+				return runGrant(opts)
 			}
 
 			cfg, err := opts.Config.Load()
@@ -81,11 +88,13 @@ func NewGrantPermissionsACLCommand(f *factory.Factory) *cobra.Command {
 
 			opts.kafkaID = instanceID
 
-			if err = validateFlagInputCombination(opts); err != nil {
-				return err
+			if opts.preset == "" {
+				if err = validateFlagInputCombination(opts); err != nil {
+					return err
+				}
 			}
 
-			return runGrantPermissions(opts)
+			return runGrant(opts)
 		},
 	}
 
@@ -102,10 +111,18 @@ func NewGrantPermissionsACLCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.topicPrefix, "topic-prefix", "", opts.localizer.MustLocalize("kafka.acl.grantPermissions.common.flag.topicPrefix.description"))
 	flags.StringVar(&opts.groupPrefix, "group-prefix", "", opts.localizer.MustLocalize("kafka.acl.grantPermissions.common.flag.groupPrefix.description"))
 	flags.BoolVar(&allAccounts, "all-accounts", false, opts.localizer.MustLocalize("kafka.acl.common.flag.allAccounts.description"))
+	flags.AddPreset(&opts.preset)
 
 	return cmd
 }
 
+func runGrant(opts *options) error {
+	if opts.preset != "" {
+		return runGrantPreset(opts)
+	}
+	return runGrantPermissions(opts)
+}
+
 // nolint:funlen
 func runGrantPermissions(opts *options) (err error) {
 
@@ -144,17 +161,7 @@ func runGrantPermissions(opts *options) (err error) {
 		groupPatternArg = kafkainstanceclient.ACLPATTERNTYPE_PREFIXED
 	}
 
-	if userID != "" {
-		opts.principal = userID
-	}
-
-	if serviceAccount != "" {
-		opts.principal = serviceAccount
-	}
-
-	if allAccounts {
-		opts.principal = aclcmdutil.Wildcard
-	}
+	setPrincipal(opts)
 
 	var aclBindRequests []kafkainstanceclient.ApiCreateAclRequest
 	var aclBindingList []kafkainstanceclient.AclBinding
@@ -262,6 +269,50 @@ func runGrantPermissions(opts *options) (err error) {
 		aclBindRequests = append(aclBindRequests, req.AclBinding(*aclBindTransactionIDDescribe))
 	}
 
+	return confirmAndCreateACLs(opts, kafkaName, aclBindingList, aclBindRequests)
+}
+
+// runGrantPreset creates the ACL rules of a preset
+func runGrantPreset(opts *options) error {
+	preset, err := aclcmdutil.GetPreset(opts.preset, opts.localizer)
+	if err != nil {
+		return err
+	}
+
+	if preset.RequiresPrefix() && opts.topicPrefix == "" {
+		return opts.localizer.MustLocalizeError("kafka.acl.grantPermissions.preset.error.prefixRequired", localize.NewEntry("Preset", preset.Name))
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	setPrincipal(opts)
+
+	var aclBindRequests []kafkainstanceclient.ApiCreateAclRequest
+	var aclBindingList []kafkainstanceclient.AclBinding
+
+	for _, b := range preset.Bindings(opts.principal, opts.topicPrefix) {
+		// the bindings of presets are validated when they are loaded
+		binding, err := b.ToAclBinding()
+		if err != nil {
+			return err
+		}
+		aclBindingList = append(aclBindingList, *binding)
+		aclBindRequests = append(aclBindRequests, api.AclsApi.CreateAcl(opts.Context).AclBinding(*binding))
+	}
+
+	return confirmAndCreateACLs(opts, kafkaInstance.GetName(), aclBindingList, aclBindRequests)
+}
+
+// confirmAndCreateACLs displays the ACL rules and creates them once confirmed
+func confirmAndCreateACLs(opts *options, kafkaName string, aclBindingList []kafkainstanceclient.AclBinding, aclBindRequests []kafkainstanceclient.ApiCreateAclRequest) (err error) {
 	rows := aclcmdutil.MapACLsToTableRows(aclBindingList, opts.localizer)
 
 	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.acl.grantPermissions.log.info.aclsPreview", len(rows)))
@@ -300,6 +351,20 @@ func runGrantPermissions(opts *options) (err error) {
 
 }
 
+func setPrincipal(opts *options) {
+	if userID != "" {
+		opts.principal = userID
+	}
+
+	if serviceAccount != "" {
+		opts.principal = serviceAccount
+	}
+
+	if allAccounts {
+		opts.principal = aclcmdutil.Wildcard
+	}
+}
+
 // validateFlagInputCombination checks if appropriate flags are provided for specified operation
 func validateFlagInputCombination(opts *options) error {
 
@@ -309,20 +374,7 @@ func validateFlagInputCombination(opts *options) error {
 		errorCollection = append(errorCollection, opts.localizer.MustLocalizeError("kafka.acl.common.error.noOperationSpecified"))
 	}
 
-	// check if principal is provided
-	if userID == "" && serviceAccount == "" && !allAccounts {
-		errorCollection = append(errorCollection, opts.localizer.MustLocalizeError("kafka.acl.common.error.noPrincipalsSelected"))
-	}
-
-	// user and service account should not be provided together
-	if userID != "" && serviceAccount != "" {
-		errorCollection = append(errorCollection, opts.localizer.MustLocalizeError("kafka.acl.common.error.bothPrincipalsSelected"))
-	}
-
-	// user and service account can't be along with "--all-accounts" flag
-	if allAccounts && (serviceAccount != "" || userID != "") {
-		errorCollection = append(errorCollection, opts.localizer.MustLocalizeError("kafka.acl.common.error.allAccountsCannotBeUsedWithUserFlag"))
-	}
+	errorCollection = append(errorCollection, aclcmdutil.ValidatePrincipalFlags(opts.localizer, userID, serviceAccount, allAccounts)...)
 
 	// checks if group resource name is provided when operation is not consumer
 	if !opts.consumer && (opts.group != "" || opts.groupPrefix != "") {
@@ -360,11 +412,24 @@ func validateFlagInputCombination(opts *options) error {
 		errorCollection = append(errorCollection, groupErr)
 	}
 
-	// user and service account should not allow wildcard
-	if userID == aclcmdutil.Wildcard || serviceAccount == aclcmdutil.Wildcard || userID == aclcmdutil.AllAlias || serviceAccount == aclcmdutil.AllAlias {
-		errorCollection = append(errorCollection, opts.localizer.MustLocalizeError("kafka.acl.common.error.useAllAccountsFlag"))
+	if len(errorCollection) > 0 {
+		return aclcmdutil.BuildInstructions(errorCollection)
+	}
+
+	return nil
+}
+
+// validatePresetFlagInputCombination checks if appropriate flags are provided for a preset
+func validatePresetFlagInputCombination(opts *options) error {
+	var errorCollection []error
+
+	// the resources and operations are defined by the preset
+	if opts.consumer || opts.producer || opts.topic != "" || opts.group != "" || opts.groupPrefix != "" {
+		errorCollection = append(errorCollection, opts.localizer.MustLocalizeError("kafka.acl.grantPermissions.preset.error.notAllowed"))
 	}
 
+	errorCollection = append(errorCollection, aclcmdutil.ValidatePrincipalFlags(opts.localizer, userID, serviceAccount, allAccounts)...)
+
 	if len(errorCollection) > 0 {
 		return aclcmdutil.BuildInstructions(errorCollection)
 	}
@@ -0,0 +1,269 @@
+package revoke
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/flagutil"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	coreFlagutil "github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/spf13/cobra"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+var (
+	serviceAccount string
+	userID         string
+	allAccounts    bool
+)
+
+type options struct {
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	IO         *iostreams.IOStreams
+	localizer  localize.Localizer
+	Context    context.Context
+
+	kafkaID     string
+	principal   string
+	topicPrefix string
+	preset      string
+	force       bool
+}
+
+// NewRevokeAccessCommand deletes the ACL rules created for a preset
+func NewRevokeAccessCommand(f *factory.Factory) *cobra.Command {
+
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "revoke-access",
+		Short:   f.Localizer.MustLocalize("kafka.acl.revokeAccess.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.acl.revokeAccess.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.acl.revokeAccess.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !opts.IO.CanPrompt() && !opts.force {
+				return coreFlagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if err := setPrincipal(opts); err != nil {
+				return err
+			}
+
+			if opts.kafkaID != "" {
+				return runRevoke(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.acl.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runRevoke(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f)
+	flags.AddInstanceID(&opts.kafkaID)
+	flags.AddYes(&opts.force)
+	flags.AddPreset(&opts.preset)
+
+	flags.StringVar(&userID, "user", "", opts.localizer.MustLocalize("kafka.acl.common.flag.user.description"))
+	flags.StringVar(&serviceAccount, "service-account", "", opts.localizer.MustLocalize("kafka.acl.common.flag.serviceAccount.description"))
+	flags.StringVar(&opts.topicPrefix, "topic-prefix", "", opts.localizer.MustLocalize("kafka.acl.revokeAccess.flag.topicPrefix.description"))
+	flags.BoolVar(&allAccounts, "all-accounts", false, opts.localizer.MustLocalize("kafka.acl.common.flag.allAccounts.description"))
+
+	_ = cmd.MarkFlagRequired("preset")
+
+	return cmd
+}
+
+// nolint:funlen
+func runRevoke(opts *options) error {
+	preset, err := aclcmdutil.GetPreset(opts.preset, opts.localizer)
+	if err != nil {
+		return err
+	}
+
+	if preset.RequiresPrefix() && opts.topicPrefix == "" {
+		return opts.localizer.MustLocalizeError("kafka.acl.grantPermissions.preset.error.prefixRequired", localize.NewEntry("Preset", preset.Name))
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	kafkaName := kafkaInstance.GetName()
+	kafkaNameTmplEntry := localize.NewEntry("Name", kafkaName)
+
+	bindings, err := bindingsToRevoke(opts, api, preset, kafkaName)
+	if err != nil {
+		return err
+	}
+
+	if len(bindings) == 0 {
+		opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalize("kafka.acl.delete.noACLsDeleted", kafkaNameTmplEntry))
+		return nil
+	}
+
+	aclBindingList := make([]kafkainstanceclient.AclBinding, 0, len(bindings))
+	for _, b := range bindings {
+		// the bindings of presets are validated when they are loaded
+		binding, err := b.ToAclBinding()
+		if err != nil {
+			return err
+		}
+		aclBindingList = append(aclBindingList, *binding)
+	}
+
+	rows := aclcmdutil.MapACLsToTableRows(aclBindingList, opts.localizer)
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.acl.revokeAccess.log.info.aclsPreview", len(rows)))
+	opts.Logger.Info()
+
+	dump.Table(opts.IO.Out, rows)
+	opts.Logger.Info()
+
+	if !opts.force {
+		var confirmRevoke bool
+		promptConfirmRevoke := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("kafka.acl.revokeAccess.input.confirmRevoke.message", localize.NewEntry("Name", kafkaName)),
+		}
+
+		if err = survey.AskOne(promptConfirmRevoke, &confirmRevoke); err != nil {
+			return err
+		}
+
+		if !confirmRevoke {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.acl.revokeAccess.log.debug.revokeNotConfirmed"))
+			return nil
+		}
+	}
+
+	var deletedCount int
+	for _, b := range bindings {
+		deletedACLs, httpRes, err := b.DeleteRequest(opts.Context, api).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err = aclcmdutil.ValidateAPIError(httpRes, opts.localizer, err, "delete", kafkaName); err != nil {
+			return err
+		}
+		deletedCount += int(deletedACLs.GetTotal())
+	}
+
+	if deletedCount == 0 {
+		opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalize("kafka.acl.delete.noACLsDeleted", kafkaNameTmplEntry))
+		return nil
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalizePlural("kafka.acl.delete.successMessage",
+		deletedCount,
+		kafkaNameTmplEntry,
+		localize.NewEntry("Count", deletedCount),
+	))
+
+	return nil
+}
+
+// bindingsToRevoke returns the bindings of the preset, without the bindings
+// which are also required by other presets granted to the principal
+func bindingsToRevoke(opts *options, api *kafkainstanceclient.APIClient, preset *aclcmdutil.Preset, kafkaName string) ([]aclcmdutil.Binding, error) {
+	path, err := aclcmdutil.PresetsFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	presets, err := aclcmdutil.LoadPresets(path)
+	if err != nil {
+		return nil, err
+	}
+
+	aclList, httpRes, err := aclcmdutil.ListAllACLs(opts.Context, api)
+	if err = aclcmdutil.ValidateAPIError(httpRes, opts.localizer, err, "list", kafkaName); err != nil {
+		return nil, err
+	}
+
+	principal := aclcmdutil.FormatPrincipal(opts.principal)
+	var current []aclcmdutil.Binding
+	for _, acl := range aclList {
+		if acl.GetPrincipal() == principal {
+			current = append(current, aclcmdutil.NewBinding(acl))
+		}
+	}
+
+	shared, grantedBy := aclcmdutil.SharedPresetBindings(presets, preset, opts.principal, opts.topicPrefix, current)
+	if len(shared) == 0 {
+		return preset.Bindings(opts.principal, opts.topicPrefix), nil
+	}
+
+	opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalizePlural("kafka.acl.revokeAccess.log.info.sharedACLsKept", len(shared),
+		localize.NewEntry("Count", len(shared)),
+		localize.NewEntry("Presets", cmdutil.StringSliceToListStringWithQuotes(grantedBy)),
+	))
+	opts.Logger.Info()
+
+	keep := make(map[aclcmdutil.Binding]bool, len(shared))
+	for _, b := range shared {
+		keep[b] = true
+	}
+
+	var bindings []aclcmdutil.Binding
+	for _, b := range preset.Bindings(opts.principal, opts.topicPrefix) {
+		if !keep[b] {
+			bindings = append(bindings, b)
+		}
+	}
+
+	return bindings, nil
+}
+
+// setPrincipal checks that a principal is selected and sets it from the flags
+func setPrincipal(opts *options) error {
+	if errorCollection := aclcmdutil.ValidatePrincipalFlags(opts.localizer, userID, serviceAccount, allAccounts); len(errorCollection) > 0 {
+		return aclcmdutil.BuildInstructions(errorCollection)
+	}
+
+	if userID != "" {
+		opts.principal = userID
+	} else if serviceAccount != "" {
+		opts.principal = serviceAccount
+	} else {
+		opts.principal = aclcmdutil.Wildcard
+	}
+
+	return nil
+}
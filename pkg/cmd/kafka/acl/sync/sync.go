@@ -0,0 +1,212 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	aclFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/flagutil"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	config     config.IConfig
+	connection factory.ConnectionFunc
+	logger     logging.Logger
+	io         *iostreams.IOStreams
+	localizer  localize.Localizer
+	context    context.Context
+
+	kafkaID     string
+	file        string
+	prune       bool
+	dryRun      bool
+	skipConfirm bool
+}
+
+// NewSyncCommand creates a new command to apply Kafka ACL rules declared in a file
+func NewSyncCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		config:     f.Config,
+		connection: f.Connection,
+		logger:     f.Logger,
+		io:         f.IOStreams,
+		localizer:  f.Localizer,
+		context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "sync",
+		Short:   f.Localizer.MustLocalize("kafka.acl.sync.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.acl.sync.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.acl.sync.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !opts.io.CanPrompt() && !opts.skipConfirm && !opts.dryRun {
+				return flagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if opts.kafkaID != "" {
+				return runSync(opts)
+			}
+
+			cfg, err := opts.config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.acl.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runSync(opts)
+		},
+	}
+
+	flags := aclFlagutil.NewFlagSet(cmd, f)
+
+	flags.StringVarP(&opts.file, "file", "f", "", opts.localizer.MustLocalize("kafka.acl.sync.flag.file.description"))
+	flags.AddInstanceID(&opts.kafkaID)
+	flags.BoolVar(&opts.prune, "prune", false, opts.localizer.MustLocalize("kafka.acl.sync.flag.prune.description"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.acl.sync.flag.dryRun.description"))
+	flags.AddYes(&opts.skipConfirm)
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// nolint:funlen
+func runSync(opts *options) error {
+	desired, err := aclcmdutil.ReadBindingsFile(opts.file)
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	kafkaName := kafkaInstance.GetName()
+	kafkaNameTmplEntry := localize.NewEntry("Name", kafkaName)
+
+	bindings, httpRes, err := aclcmdutil.ListAllACLs(opts.context, api)
+	if err = aclcmdutil.ValidateAPIError(httpRes, opts.localizer, err, "list", kafkaName); err != nil {
+		return err
+	}
+
+	current := aclcmdutil.NewBindingsFile(bindings)
+	changes := aclcmdutil.PlanSync(current.Bindings, desired.Bindings, opts.prune)
+
+	if len(changes) == 0 {
+		opts.logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("kafka.acl.sync.log.info.inSync", kafkaNameTmplEntry))
+		return nil
+	}
+
+	resourceOperations, httpRes, err := api.AclsApi.GetAclResourceOperations(opts.context).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		if change.Action != aclcmdutil.SyncActionCreate {
+			continue
+		}
+		binding := change.Binding
+		if isValidOp, validResourceOperations := aclcmdutil.IsValidResourceOperation(binding.ResourceType, binding.Operation, resourceOperations); !isValidOp {
+			return opts.localizer.MustLocalizeError("kafka.acl.common.error.invalidResourceOperation",
+				localize.NewEntry("ResourceType", binding.ResourceType),
+				localize.NewEntry("Operation", binding.Operation),
+				localize.NewEntry("ValidOperationList", cmdutil.StringSliceToListStringWithQuotes(validResourceOperations)),
+			)
+		}
+	}
+
+	opts.logger.Info(opts.localizer.MustLocalizePlural("kafka.acl.sync.log.info.plan", len(changes),
+		localize.NewEntry("Count", len(changes)),
+		kafkaNameTmplEntry,
+	))
+	opts.logger.Info()
+	dump.Table(opts.io.Out, aclcmdutil.MapSyncChangesToTableRows(changes, opts.localizer))
+	opts.logger.Info()
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.skipConfirm {
+		prompt := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("kafka.acl.sync.input.confirmSyncMessage", kafkaNameTmplEntry),
+		}
+		if err = survey.AskOne(prompt, &opts.skipConfirm); err != nil {
+			return err
+		}
+
+		if !opts.skipConfirm {
+			opts.logger.Debug(opts.localizer.MustLocalize("kafka.acl.sync.log.debug.syncNotConfirmed"))
+			return nil
+		}
+	}
+
+	spinnr := spinner.New(opts.io.ErrOut, opts.localizer)
+	spinnr.SetLocalizedSuffix("kafka.acl.sync.log.info.syncingACLs", kafkaNameTmplEntry)
+	spinnr.Start()
+
+	var created, deleted int
+	for _, change := range changes {
+		if change.Action == aclcmdutil.SyncActionCreate {
+			// the bindings were validated when reading the file
+			binding, _ := change.Binding.ToAclBinding()
+			req := api.AclsApi.CreateAcl(opts.context).AclBinding(*binding)
+			if err = aclcmdutil.ExecuteACLRuleCreate(req, opts.localizer, kafkaName); err != nil {
+				spinnr.Stop()
+				return err
+			}
+			created++
+			continue
+		}
+
+		_, httpRes, err := change.Binding.DeleteRequest(opts.context, api).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err = aclcmdutil.ValidateAPIError(httpRes, opts.localizer, err, "delete", kafkaName); err != nil {
+			spinnr.Stop()
+			return err
+		}
+		deleted++
+	}
+	spinnr.Stop()
+
+	opts.logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("kafka.acl.sync.log.info.synced",
+		localize.NewEntry("Created", created),
+		localize.NewEntry("Deleted", deleted),
+		kafkaNameTmplEntry,
+	))
+
+	return nil
+}
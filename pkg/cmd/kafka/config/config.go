@@ -0,0 +1,24 @@
+package config
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/config/generate"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewConfigCommand creates a new command sub-group for Kafka client configuration
+func NewConfigCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "config",
+		Short:   f.Localizer.MustLocalize("kafka.config.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.config.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.config.cmd.example"),
+		Args:    cobra.ExactArgs(1),
+	}
+
+	cmd.AddCommand(
+		generate.NewGenerateCommand(f),
+	)
+
+	return cmd
+}
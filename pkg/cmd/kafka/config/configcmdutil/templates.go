@@ -0,0 +1,142 @@
+package configcmdutil
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/MakeNowJust/heredoc"
+)
+
+// valid values for the client configuration type
+const (
+	JavaPropertiesType = "java-properties"
+	LibrdkafkaType     = "librdkafka"
+	EnvType            = "env"
+	KcatType           = "kcat"
+	SpringType         = "spring"
+	QuarkusType        = "quarkus"
+)
+
+var ValidConfigTypes = []string{JavaPropertiesType, LibrdkafkaType, EnvType, KcatType, SpringType, QuarkusType}
+
+// ClientConfig contains the values used to
+// generate a Kafka client configuration file
+type ClientConfig struct {
+	BootstrapServer string
+	ClientID        string
+	ClientSecret    string
+	TokenURL        string
+}
+
+// Templates
+var (
+	templateJavaProperties = heredoc.Doc(`
+	## Generated by rhoas cli
+	bootstrap.servers={{.BootstrapServer}}
+	security.protocol=SASL_SSL
+	sasl.mechanism=OAUTHBEARER
+	sasl.jaas.config=org.apache.kafka.common.security.oauthbearer.OAuthBearerLoginModule required oauth.client.id="{{.ClientID}}" oauth.client.secret="{{.ClientSecret}}" oauth.token.endpoint.uri="{{.TokenURL}}";
+	sasl.login.callback.handler.class=io.strimzi.kafka.oauth.client.JaasClientOauthLoginCallbackHandler
+	`)
+
+	templateLibrdkafka = heredoc.Doc(`
+	## Generated by rhoas cli
+	bootstrap.servers={{.BootstrapServer}}
+	security.protocol=SASL_SSL
+	sasl.mechanisms=OAUTHBEARER
+	sasl.oauthbearer.method=oidc
+	sasl.oauthbearer.client.id={{.ClientID}}
+	sasl.oauthbearer.client.secret={{.ClientSecret}}
+	sasl.oauthbearer.token.endpoint.url={{.TokenURL}}
+	`)
+
+	templateEnv = heredoc.Doc(`
+	## Generated by rhoas cli
+	KAFKA_HOST={{.BootstrapServer}}
+	RHOAS_SERVICE_ACCOUNT_CLIENT_ID={{.ClientID}}
+	RHOAS_SERVICE_ACCOUNT_CLIENT_SECRET={{.ClientSecret}}
+	RHOAS_SERVICE_ACCOUNT_OAUTH_TOKEN_URL={{.TokenURL}}
+	`)
+
+	// kcat uses the librdkafka OIDC support, which requires librdkafka 1.9.0 or later
+	templateKcat = heredoc.Doc(`
+	## Generated by rhoas cli
+	## Usage: kcat -F <this file> -L
+	bootstrap.servers={{.BootstrapServer}}
+	security.protocol=SASL_SSL
+	sasl.mechanisms=OAUTHBEARER
+	sasl.oauthbearer.method=oidc
+	sasl.oauthbearer.client.id={{.ClientID}}
+	sasl.oauthbearer.client.secret={{.ClientSecret}}
+	sasl.oauthbearer.token.endpoint.url={{.TokenURL}}
+	`)
+
+	templateSpring = heredoc.Doc(`
+	## Generated by rhoas cli
+	spring.kafka.bootstrap-servers={{.BootstrapServer}}
+	spring.kafka.properties.security.protocol=SASL_SSL
+	spring.kafka.properties.sasl.mechanism=OAUTHBEARER
+	spring.kafka.properties.sasl.jaas.config=org.apache.kafka.common.security.oauthbearer.OAuthBearerLoginModule required oauth.client.id="{{.ClientID}}" oauth.client.secret="{{.ClientSecret}}" oauth.token.endpoint.uri="{{.TokenURL}}";
+	spring.kafka.properties.sasl.login.callback.handler.class=io.strimzi.kafka.oauth.client.JaasClientOauthLoginCallbackHandler
+	`)
+
+	templateQuarkus = heredoc.Doc(`
+	## Generated by rhoas cli
+	kafka.bootstrap.servers={{.BootstrapServer}}
+	kafka.security.protocol=SASL_SSL
+	kafka.sasl.mechanism=OAUTHBEARER
+	kafka.sasl.jaas.config=org.apache.kafka.common.security.oauthbearer.OAuthBearerLoginModule required oauth.client.id="{{.ClientID}}" oauth.client.secret="{{.ClientSecret}}" oauth.token.endpoint.uri="{{.TokenURL}}";
+	kafka.sasl.login.callback.handler.class=io.strimzi.kafka.oauth.client.JaasClientOauthLoginCallbackHandler
+	`)
+)
+
+var configTemplates = map[string]string{
+	JavaPropertiesType: templateJavaProperties,
+	LibrdkafkaType:     templateLibrdkafka,
+	EnvType:            templateEnv,
+	KcatType:           templateKcat,
+	SpringType:         templateSpring,
+	QuarkusType:        templateQuarkus,
+}
+
+var defaultFileNames = map[string]string{
+	JavaPropertiesType: "kafka.properties",
+	LibrdkafkaType:     "librdkafka.properties",
+	EnvType:            "kafka.env",
+	KcatType:           "kcat.conf",
+	SpringType:         "application.properties",
+	QuarkusType:        "application.properties",
+}
+
+// Render generates the client configuration for the given configuration type
+func Render(configType string, cfg *ClientConfig) ([]byte, error) {
+	tmplText, ok := configTemplates[configType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported configuration type %q", configType)
+	}
+
+	tmpl, err := template.New(configType).Parse(tmplText)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, cfg); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GetDefaultPath returns the default absolute path for the client configuration file
+func GetDefaultPath(configType string) string {
+	pwd, err := os.Getwd()
+	if err != nil {
+		pwd = "./"
+	}
+
+	return filepath.Join(pwd, defaultFileNames[configType])
+}
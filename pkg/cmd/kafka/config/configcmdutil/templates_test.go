@@ -0,0 +1,34 @@
+package configcmdutil
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/internal/testutil"
+)
+
+func TestRender(t *testing.T) {
+	cfg := &ClientConfig{
+		BootstrapServer: "my-kafka--abcdefghijklmnop.bf2.kafka.rhcloud.com:443",
+		ClientID:        "srvc-acct-11111111-2222-3333-4444-555555555555",
+		ClientSecret:    "66666666-7777-8888-9999-000000000000",
+		TokenURL:        "https://identity.api.openshift.com/auth/realms/rhoas/protocol/openid-connect/token",
+	}
+
+	for _, configType := range ValidConfigTypes {
+		// nolint:scopelint
+		t.Run(configType, func(t *testing.T) {
+			got, err := Render(configType, cfg)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			testutil.AssertGolden(t, configType, got)
+		})
+	}
+}
+
+func TestRenderInvalidType(t *testing.T) {
+	if _, err := Render("xml", &ClientConfig{}); err == nil {
+		t.Error("Render() expected error for unsupported configuration type")
+	}
+}
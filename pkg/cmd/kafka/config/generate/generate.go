@@ -0,0 +1,200 @@
+package generate
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/config/configcmdutil"
+	kafkaFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccountutil/credentials"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	kafkaID         string
+	configType      string
+	credentialsFile string
+	filename        string
+	overwrite       bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewGenerateCommand creates a new command to generate a Kafka client configuration file
+func NewGenerateCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "generate",
+		Short:   opts.localizer.MustLocalize("kafka.config.generate.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.config.generate.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.config.generate.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !opts.IO.CanPrompt() && opts.configType == "" {
+				return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "type"))
+			}
+
+			if !opts.IO.CanPrompt() && opts.credentialsFile == "" {
+				return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "credentials-file"))
+			}
+
+			if opts.configType != "" && !flagutil.IsValidInput(opts.configType, configcmdutil.ValidConfigTypes...) {
+				return flagutil.InvalidValueError("type", opts.configType, configcmdutil.ValidConfigTypes...)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if opts.kafkaID == "" {
+				instanceID, ok := cfg.GetKafkaIdOk()
+				if !ok {
+					return opts.localizer.MustLocalizeError("kafka.common.error.noKafkaSelected")
+				}
+				opts.kafkaID = instanceID
+			}
+
+			return runGenerate(opts, cfg)
+		},
+	}
+
+	flags := kafkaFlagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.AddInstanceID(&opts.kafkaID)
+	flags.StringVar(&opts.configType, "type", "", flagutil.FlagDescription(opts.localizer, "kafka.config.generate.flag.type.description", configcmdutil.ValidConfigTypes...))
+	flags.StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.config.generate.flag.credentialsFile.description"))
+	flags.StringVar(&opts.filename, "output-file", "", opts.localizer.MustLocalize("kafka.config.generate.flag.outputFile.description"))
+	flags.BoolVar(&opts.overwrite, "overwrite", false, opts.localizer.MustLocalize("kafka.config.generate.flag.overwrite.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "type", configcmdutil.ValidConfigTypes)
+
+	return cmd
+}
+
+func runGenerate(opts *options, cfg *config.Config) error {
+	if opts.configType == "" || opts.credentialsFile == "" {
+		if err := runInteractivePrompt(opts); err != nil {
+			return err
+		}
+	}
+
+	if opts.filename == "" {
+		opts.filename = configcmdutil.GetDefaultPath(opts.configType)
+	}
+	opts.filename = os.ExpandEnv(opts.filename)
+
+	// If the configuration file already exists, and the --overwrite flag is not set then return an error
+	// indicating that the user should explicitly request overwriting of the file
+	if _, err := os.Stat(opts.filename); err == nil && !opts.overwrite {
+		return opts.localizer.MustLocalizeError("kafka.config.generate.error.fileAlreadyExists", localize.NewEntry("FilePath", opts.filename))
+	}
+
+	creds, err := credentials.Read(opts.credentialsFile)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.config.generate.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	kafkaInstance, httpRes, err := kafkautil.GetKafkaByID(opts.Context, conn.API().KafkaMgmt(), opts.kafkaID)
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	bootstrapHost, ok := kafkaInstance.GetBootstrapServerHostOk()
+	if !ok || *bootstrapHost == "" {
+		return opts.localizer.MustLocalizeError("kafka.config.generate.error.bootstrapServerNotAvailable", localize.NewEntry("Name", kafkaInstance.GetName()))
+	}
+
+	tokenURL := creds.TokenURL
+	if cfg.MasAuthURL != "" {
+		tokenURL = cfg.MasAuthURL + "/protocol/openid-connect/token"
+	}
+
+	clientConfig := &configcmdutil.ClientConfig{
+		BootstrapServer: *bootstrapHost,
+		ClientID:        creds.ClientID,
+		ClientSecret:    creds.ClientSecret,
+		TokenURL:        tokenURL,
+	}
+
+	fileData, err := configcmdutil.Render(opts.configType, clientConfig)
+	if err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(opts.filename, fileData, 0o600); err != nil {
+		return opts.localizer.MustLocalizeError("kafka.config.generate.error.couldNotSaveFile", localize.NewEntry("ErrorMessage", err))
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("kafka.config.generate.log.info.configSaved",
+		localize.NewEntry("FilePath", color.CodeSnippet(opts.filename)),
+		localize.NewEntry("InstanceName", color.Info(kafkaInstance.GetName())),
+	))
+
+	return nil
+}
+
+func runInteractivePrompt(opts *options) error {
+	opts.Logger.Debug(opts.localizer.MustLocalize("common.log.debug.startingInteractivePrompt"))
+
+	if opts.configType == "" {
+		configTypePrompt := &survey.Select{
+			Message: opts.localizer.MustLocalize("kafka.config.generate.input.type.message"),
+			Help:    opts.localizer.MustLocalize("kafka.config.generate.input.type.help"),
+			Options: configcmdutil.ValidConfigTypes,
+			Default: configcmdutil.JavaPropertiesType,
+		}
+
+		if err := survey.AskOne(configTypePrompt, &opts.configType); err != nil {
+			return err
+		}
+	}
+
+	if opts.credentialsFile == "" {
+		credentialsFilePrompt := &survey.Input{
+			Message: opts.localizer.MustLocalize("kafka.config.generate.input.credentialsFile.message"),
+			Help:    opts.localizer.MustLocalize("kafka.config.generate.input.credentialsFile.help"),
+			Default: credentials.GetDefaultPath(credentials.EnvFormat),
+		}
+
+		if err := survey.AskOne(credentialsFilePrompt, &opts.credentialsFile, survey.WithValidator(survey.Required)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@ package consumergroup
 import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/describe"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/lag"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/offsets"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/resetoffset"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/spf13/cobra"
@@ -24,6 +26,8 @@ func NewConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 		delete.NewDeleteConsumerGroupCommand(f),
 		describe.NewDescribeConsumerGroupCommand(f),
 		resetoffset.NewResetOffsetConsumerGroupCommand(f),
+		lag.NewLagConsumerGroupCommand(f),
+		offsets.NewOffsetsCommand(f),
 	)
 
 	return cmd
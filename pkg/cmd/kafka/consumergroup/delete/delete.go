@@ -4,16 +4,21 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/groupcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
 	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/bulkutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +26,10 @@ type options struct {
 	kafkaID     string
 	id          string
 	skipConfirm bool
+	search      string
+	pattern     string
+	topic       string
+	emptyOnly   bool
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -48,6 +57,19 @@ func NewDeleteConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 		Example: opts.localizer.MustLocalize("kafka.consumerGroup.delete.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			bulk := opts.search != "" || opts.pattern != "" || opts.topic != "" || opts.emptyOnly
+			if opts.id != "" && bulk {
+				return opts.localizer.MustLocalizeError("kafka.consumerGroup.delete.error.idWithFilters")
+			}
+
+			if opts.id == "" && !bulk {
+				return opts.localizer.MustLocalizeError("kafka.consumerGroup.delete.error.idOrFilterRequired")
+			}
+
+			if bulk && !opts.IO.CanPrompt() && !opts.skipConfirm {
+				return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "yes"))
+			}
+
 			if opts.kafkaID != "" {
 				return runCmd(opts)
 			}
@@ -72,13 +94,20 @@ func NewDeleteConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 
 	flags.AddYes(&opts.skipConfirm)
 	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.consumerGroup.common.flag.id.description", localize.NewEntry("Action", "delete")))
-	_ = cmd.MarkFlagRequired("id")
+	flags.StringVar(&opts.search, "search", "", opts.localizer.MustLocalize("kafka.consumerGroup.list.flag.search"))
+	flags.StringVar(&opts.pattern, "pattern", "", opts.localizer.MustLocalize("kafka.consumerGroup.delete.flag.pattern.description"))
+	flags.StringVar(&opts.topic, "topic", "", opts.localizer.MustLocalize("kafka.consumerGroup.delete.flag.topic.description"))
+	flags.BoolVar(&opts.emptyOnly, "empty-only", false, opts.localizer.MustLocalize("kafka.consumerGroup.delete.flag.emptyOnly.description"))
 
 	// flag based completions for ID
 	_ = cmd.RegisterFlagCompletionFunc("id", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return kafkacmdutil.FilterValidConsumerGroupIDs(f, toComplete)
 	})
 
+	_ = cmd.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
 	return cmd
 }
 
@@ -94,6 +123,10 @@ func runCmd(opts *options) error {
 		return err
 	}
 
+	if opts.id == "" {
+		return runBulkDelete(opts, api, kafkaInstance)
+	}
+
 	_, httpRes, err := api.GroupsApi.GetConsumerGroupById(opts.Context, opts.id).Execute()
 	if httpRes != nil {
 		defer httpRes.Body.Close()
@@ -126,7 +159,86 @@ func runCmd(opts *options) error {
 		}
 	}
 
-	httpRes, err = api.GroupsApi.DeleteConsumerGroupById(opts.Context, opts.id).Execute()
+	if err = deleteConsumerGroup(opts, api, kafkaInstance, opts.id); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.info.consumerGroupDeleted", localize.NewEntry("ConsumerGroupID", opts.id), kafkaNameTmplPair))
+
+	return nil
+}
+
+// runBulkDelete deletes all consumer groups matching the filters
+func runBulkDelete(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	groups, httpRes, err := groupcmdutil.ListAllConsumerGroups(opts.Context, api, opts.topic, opts.search)
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "list")
+
+		switch httpRes.StatusCode {
+		case http.StatusUnauthorized:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return err
+		}
+	}
+
+	ids, err := groupcmdutil.FilterConsumerGroups(opts.localizer, groups, opts.pattern, opts.emptyOnly)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.info.noConsumerGroupsMatch", kafkaNameTmplPair))
+		return nil
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.consumerGroup.delete.log.info.consumerGroupsPreview", len(ids), kafkaNameTmplPair, localize.NewEntry("Count", len(ids))))
+	opts.Logger.Info()
+	for _, id := range ids {
+		opts.Logger.Info("  " + id)
+	}
+	opts.Logger.Info()
+
+	if !opts.skipConfirm {
+		confirmed, err := bulkutil.Confirm(opts.localizer)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.debug.deleteNotConfirmed"))
+			return nil
+		}
+	}
+
+	// the consumer groups are independent, so a failure does not stop the other deletions
+	results := bulkutil.Run(ids, true, func(id string) error {
+		return deleteConsumerGroup(opts, api, kafkaInstance, id)
+	})
+
+	dump.Table(opts.IO.Out, bulkutil.MapResultsToTableRows(results, opts.localizer, opts.localizer.MustLocalize("kafka.consumerGroup.delete.bulk.status.deleted")))
+
+	if failed, _ := bulkutil.CountFailures(results); failed > 0 {
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.delete.error.bulkFailed", localize.NewEntry("Failed", failed), localize.NewEntry("Total", len(results)))
+	}
+
+	return nil
+}
+
+// deleteConsumerGroup performs the delete consumer group API request
+func deleteConsumerGroup(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest, id string) error {
+	httpRes, err := api.GroupsApi.DeleteConsumerGroupById(opts.Context, id).Execute()
 	if httpRes != nil {
 		defer httpRes.Body.Close()
 	}
@@ -139,6 +251,8 @@ func runCmd(opts *options) error {
 		operationTmplPair := localize.NewEntry("Operation", "delete")
 
 		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.notFoundError", localize.NewEntry("ID", id), localize.NewEntry("InstanceName", kafkaInstance.GetName()))
 		case http.StatusUnauthorized:
 			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unauthorized", operationTmplPair)
 		case http.StatusForbidden:
@@ -154,7 +268,5 @@ func runCmd(opts *options) error {
 		}
 	}
 
-	opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.info.consumerGroupDeleted", localize.NewEntry("ConsumerGroupID", opts.id), kafkaNameTmplPair))
-
 	return nil
 }
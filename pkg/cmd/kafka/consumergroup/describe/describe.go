@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"sort"
 
+	"github.com/Shopify/sarama"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/groupcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messagecmdutil"
 	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
@@ -18,6 +20,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 
 	"github.com/spf13/cobra"
@@ -27,10 +30,14 @@ type options struct {
 	kafkaID      string
 	outputFormat string
 	id           string
+	members      bool
+
+	credentialsFile string
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
 	Connection factory.ConnectionFunc
+	Logger     logging.Logger
 	localizer  localize.Localizer
 	Context    context.Context
 }
@@ -49,6 +56,7 @@ func NewDescribeConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 	opts := &options{
 		Connection: f.Connection,
 		Config:     f.Config,
+		Logger:     f.Logger,
 		IO:         f.IOStreams,
 		localizer:  f.Localizer,
 		Context:    f.Context,
@@ -90,6 +98,8 @@ func NewDescribeConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 
 	flags.AddOutput(&opts.outputFormat)
 	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.consumerGroup.common.flag.id.description", localize.NewEntry("Action", "view")))
+	flags.BoolVar(&opts.members, "members", false, opts.localizer.MustLocalize("kafka.consumerGroup.describe.flag.members.description"))
+	flags.StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.credentialsFile.description"))
 	_ = cmd.MarkFlagRequired("id")
 
 	// flag based completions for ID
@@ -143,6 +153,10 @@ func runCmd(opts *options) error {
 		}
 	}
 
+	if opts.members {
+		return describeMembers(opts, kafkaInstance.GetBootstrapServerHost(), &consumerGroupData)
+	}
+
 	stdout := opts.IO.Out
 
 	switch opts.outputFormat {
@@ -199,3 +213,58 @@ func printConsumerGroupDetails(w io.Writer, consumerGroupData kafkainstanceclien
 	rows := mapConsumerGroupDescribeToTableFormat(consumers)
 	dump.Table(w, rows)
 }
+
+// describeMembers prints the partitions of the consumer group grouped by member,
+// along with the clients of the members when they can be read from the Kafka instance
+func describeMembers(opts *options, bootstrapServer string, consumerGroupData *kafkainstanceclient.ConsumerGroup) error {
+	// the configuration is loaded after the connection is created,
+	// so that it contains the refreshed access token
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	tokenSource, err := messagecmdutil.NewTokenSource(opts.Context, cfg, opts.credentialsFile)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+	}
+
+	var clients map[string]groupcmdutil.MemberClient
+	client, err := sarama.NewClient([]string{bootstrapServer}, messagecmdutil.NewClientConfig(tokenSource))
+	if err != nil {
+		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.describe.log.debug.membersNotAvailable", localize.NewEntry("ErrorMessage", err)))
+	} else {
+		defer client.Close()
+
+		clients, err = groupcmdutil.NewMemberReader(client).Clients(opts.id)
+		if err != nil {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.describe.log.debug.membersNotAvailable", localize.NewEntry("ErrorMessage", err)))
+		}
+	}
+
+	members := groupcmdutil.NewGroupMembers(consumerGroupData, clients)
+
+	if opts.outputFormat != "" {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, members)
+	}
+
+	w := opts.IO.Out
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, color.Bold(opts.localizer.MustLocalize("kafka.consumerGroup.describe.output.state")), groupcmdutil.ValueOrDash(members.State), "\t", color.Bold(opts.localizer.MustLocalize("kafka.consumerGroup.describe.output.activeMembers")), len(members.Members))
+	fmt.Fprintln(w, "")
+
+	if len(members.Members) > 0 {
+		dump.Table(w, groupcmdutil.MapMembersToTableRows(members.Members))
+		fmt.Fprintln(w, "")
+	}
+
+	// partitions without an active consumer are not being consumed
+	if len(members.Unassigned) > 0 {
+		fmt.Fprintln(w, color.Error(opts.localizer.MustLocalizePlural("kafka.consumerGroup.describe.output.partitionsWithoutConsumer", len(members.Unassigned),
+			localize.NewEntry("Count", len(members.Unassigned)),
+			localize.NewEntry("Partitions", groupcmdutil.FormatTopicPartitions(members.Unassigned)),
+		)))
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package groupcmdutil
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"gopkg.in/yaml.v2"
+)
+
+// OffsetsBackup contains the committed offsets of a consumer group
+type OffsetsBackup struct {
+	GroupID string            `json:"groupId" yaml:"groupId"`
+	Offsets []CommittedOffset `json:"offsets" yaml:"offsets"`
+}
+
+// CommittedOffset is the committed offset of a consumer group on a partition
+type CommittedOffset struct {
+	Topic     string `json:"topic" yaml:"topic" header:"Topic"`
+	Partition int32  `json:"partition" yaml:"partition" header:"Partition"`
+	Offset    int64  `json:"offset" yaml:"offset" header:"Offset"`
+}
+
+// NewOffsetsBackup creates a backup of the committed offsets of the consumer group,
+// sorted by topic and partition
+func NewOffsetsBackup(groupID string, offsets []PartitionOffsets) *OffsetsBackup {
+	backup := &OffsetsBackup{
+		GroupID: groupID,
+		Offsets: []CommittedOffset{},
+	}
+
+	for _, offset := range offsets {
+		// members without partitions are listed with partition -1
+		if offset.Partition < 0 {
+			continue
+		}
+		backup.Offsets = append(backup.Offsets, CommittedOffset{
+			Topic:     offset.Topic,
+			Partition: offset.Partition,
+			Offset:    offset.Offset,
+		})
+	}
+
+	sort.Slice(backup.Offsets, func(i, j int) bool {
+		if backup.Offsets[i].Topic != backup.Offsets[j].Topic {
+			return backup.Offsets[i].Topic < backup.Offsets[j].Topic
+		}
+		return backup.Offsets[i].Partition < backup.Offsets[j].Partition
+	})
+
+	return backup
+}
+
+// ReadOffsetsBackup reads a backup of committed offsets from a JSON or YAML file
+func ReadOffsetsBackup(path string) (*OffsetsBackup, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var backup OffsetsBackup
+	if err = yaml.Unmarshal(data, &backup); err != nil {
+		return nil, fmt.Errorf("invalid offsets file %v: %w", path, err)
+	}
+
+	if len(backup.Offsets) == 0 {
+		return nil, fmt.Errorf("invalid offsets file %v: no offsets are defined", path)
+	}
+
+	seen := map[string]bool{}
+	for _, offset := range backup.Offsets {
+		if offset.Topic == "" {
+			return nil, fmt.Errorf("invalid offsets file %v: an offset has no topic", path)
+		}
+		if offset.Partition < 0 || offset.Offset < 0 {
+			return nil, fmt.Errorf("invalid offsets file %v: invalid offset %v for partition %v of topic %q", path, offset.Offset, offset.Partition, offset.Topic)
+		}
+
+		key := fmt.Sprintf("%v/%v", offset.Topic, offset.Partition)
+		if seen[key] {
+			return nil, fmt.Errorf("invalid offsets file %v: duplicate offset for partition %v of topic %q", path, offset.Partition, offset.Topic)
+		}
+		seen[key] = true
+	}
+
+	return &backup, nil
+}
+
+// ResetParameters creates the requests which restore the offsets as absolute offsets.
+// Partitions of a topic with the same offset are restored by a single request
+func (b *OffsetsBackup) ResetParameters() []kafkainstanceclient.ConsumerGroupResetOffsetParameters {
+	type target struct {
+		topic  string
+		offset int64
+	}
+
+	var targets []target
+	partitions := map[target][]int32{}
+	for _, offset := range b.Offsets {
+		t := target{topic: offset.Topic, offset: offset.Offset}
+		if _, ok := partitions[t]; !ok {
+			targets = append(targets, t)
+		}
+		partitions[t] = append(partitions[t], offset.Partition)
+	}
+
+	params := make([]kafkainstanceclient.ConsumerGroupResetOffsetParameters, len(targets))
+	for i, t := range targets {
+		value := strconv.FormatInt(t.offset, 10)
+		p := partitions[t]
+		topics := []kafkainstanceclient.TopicsToResetOffset{{Topic: t.topic, Partitions: &p}}
+		params[i] = kafkainstanceclient.ConsumerGroupResetOffsetParameters{
+			Offset: OffsetAbsolute,
+			Value:  &value,
+			Topics: &topics,
+		}
+	}
+
+	return params
+}
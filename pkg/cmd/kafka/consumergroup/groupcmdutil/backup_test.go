@@ -0,0 +1,93 @@
+package groupcmdutil
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func TestNewOffsetsBackup(t *testing.T) {
+	offsets := []PartitionOffsets{
+		newCommittedOffset("orders", 1, 80, 100),
+		newCommittedOffset("audit", 0, 5, 10),
+		newCommittedOffset("orders", 0, 50, 100),
+		newCommittedOffset("", -1, 0, 0),
+	}
+
+	want := []CommittedOffset{
+		{Topic: "audit", Partition: 0, Offset: 5},
+		{Topic: "orders", Partition: 0, Offset: 50},
+		{Topic: "orders", Partition: 1, Offset: 80},
+	}
+
+	backup := NewOffsetsBackup("my-group", offsets)
+	if backup.GroupID != "my-group" || !reflect.DeepEqual(backup.Offsets, want) {
+		t.Errorf("NewOffsetsBackup() = %+v, want offsets %+v", backup, want)
+	}
+}
+
+func TestReadOffsetsBackup(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"offsets.json":   `{"groupId": "my-group", "offsets": [{"topic": "orders", "partition": 0, "offset": 50}]}`,
+		"offsets.yaml":   "groupId: my-group\noffsets:\n- topic: orders\n  partition: 1\n  offset: 80\n",
+		"empty.json":     `{"groupId": "my-group", "offsets": []}`,
+		"duplicate.json": `{"offsets": [{"topic": "orders", "partition": 0, "offset": 1}, {"topic": "orders", "partition": 0, "offset": 2}]}`,
+		"negative.json":  `{"offsets": [{"topic": "orders", "partition": 0, "offset": -1}]}`,
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		wantErr bool
+	}{
+		{name: "Should read offsets in JSON format", file: "offsets.json"},
+		{name: "Should read offsets in YAML format", file: "offsets.yaml"},
+		{name: "Should fail when no offsets are defined", file: "empty.json", wantErr: true},
+		{name: "Should fail for duplicate partitions", file: "duplicate.json", wantErr: true},
+		{name: "Should fail for negative offsets", file: "negative.json", wantErr: true},
+		{name: "Should fail for a missing file", file: "missing.json", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			backup, err := ReadOffsetsBackup(filepath.Join(dir, tt.file))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadOffsetsBackup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (backup.GroupID != "my-group" || len(backup.Offsets) != 1) {
+				t.Errorf("ReadOffsetsBackup() = %+v", backup)
+			}
+		})
+	}
+}
+
+func TestOffsetsBackupResetParameters(t *testing.T) {
+	backup := &OffsetsBackup{
+		GroupID: "my-group",
+		Offsets: []CommittedOffset{
+			{Topic: "orders", Partition: 0, Offset: 50},
+			{Topic: "orders", Partition: 1, Offset: 80},
+			{Topic: "orders", Partition: 2, Offset: 50},
+		},
+	}
+
+	params := backup.ResetParameters()
+	if len(params) != 2 {
+		t.Fatalf("ResetParameters() = %+v, want 2 requests", params)
+	}
+
+	want := []kafkainstanceclient.TopicsToResetOffset{{Topic: "orders", Partitions: &[]int32{0, 2}}}
+	if params[0].Offset != OffsetAbsolute || params[0].GetValue() != "50" || !reflect.DeepEqual(params[0].GetTopics(), want) {
+		t.Errorf("ResetParameters() first request = %+v", params[0])
+	}
+}
@@ -0,0 +1,168 @@
+package groupcmdutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+// PrometheusFormat prints the lag in the Prometheus text exposition format
+const PrometheusFormat = "prometheus"
+
+const listPageSize = 100
+
+// names of the metrics in the Prometheus format
+const (
+	totalLagMetric = "rhoas_kafka_consumergroup_lag"
+	maxLagMetric   = "rhoas_kafka_consumergroup_lag_max"
+)
+
+// GroupLag is the offset lag of a consumer group on a topic
+type GroupLag struct {
+	GroupID    string `json:"groupId" yaml:"groupId"`
+	Topic      string `json:"topic" yaml:"topic"`
+	Partitions int    `json:"partitions" yaml:"partitions"`
+	TotalLag   int64  `json:"totalLag" yaml:"totalLag"`
+	MaxLag     int64  `json:"maxLag" yaml:"maxLag"`
+}
+
+// GroupLagRow is the table representation of the lag of a consumer group on a topic
+type GroupLagRow struct {
+	GroupID    string `header:"Consumer group ID"`
+	Topic      string `header:"Topic"`
+	Partitions int    `header:"Partitions"`
+	TotalLag   int64  `header:"Total lag"`
+	MaxLag     int64  `header:"Max partition lag"`
+}
+
+// ListAllConsumerGroups fetches all consumer groups of a Kafka instance, optionally filtered
+// by topic and consumer group ID
+func ListAllConsumerGroups(ctx context.Context, api *kafkainstanceclient.APIClient, topic string, search string) ([]kafkainstanceclient.ConsumerGroup, *http.Response, error) {
+	var groups []kafkainstanceclient.ConsumerGroup
+	for page := int32(1); ; page++ {
+		req := api.GroupsApi.GetConsumerGroups(ctx).Page(page).Size(listPageSize)
+		if topic != "" {
+			req = req.Topic(topic)
+		}
+		if search != "" {
+			req = req.GroupIdFilter(search)
+		}
+
+		groupData, httpRes, err := req.Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, httpRes, err
+		}
+
+		items := groupData.GetItems()
+		groups = append(groups, items...)
+
+		if len(items) == 0 || len(groups) >= int(groupData.GetTotal()) {
+			return groups, httpRes, nil
+		}
+	}
+}
+
+// ComputeLag aggregates the offset lag of the partitions of each consumer group by topic.
+// When topic is set, only the lag on that topic is returned.
+// The result is sorted by consumer group ID and topic
+func ComputeLag(groups []kafkainstanceclient.ConsumerGroup, topic string) []GroupLag {
+	var lags []GroupLag
+	for _, group := range groups {
+		byTopic := map[string]*GroupLag{}
+		for _, consumer := range group.GetConsumers() {
+			// members without partitions are listed with partition -1
+			if consumer.GetPartition() < 0 || (topic != "" && consumer.GetTopic() != topic) {
+				continue
+			}
+
+			lag, ok := byTopic[consumer.GetTopic()]
+			if !ok {
+				lag = &GroupLag{GroupID: group.GetGroupId(), Topic: consumer.GetTopic()}
+				byTopic[consumer.GetTopic()] = lag
+			}
+
+			partitionLag := int64(consumer.GetLag())
+			lag.Partitions++
+			lag.TotalLag += partitionLag
+			if partitionLag > lag.MaxLag {
+				lag.MaxLag = partitionLag
+			}
+		}
+
+		for _, lag := range byTopic {
+			lags = append(lags, *lag)
+		}
+	}
+
+	sort.Slice(lags, func(i, j int) bool {
+		if lags[i].GroupID != lags[j].GroupID {
+			return lags[i].GroupID < lags[j].GroupID
+		}
+		return lags[i].Topic < lags[j].Topic
+	})
+
+	return lags
+}
+
+// ExceedingLag returns the lags whose total lag is greater than the threshold
+func ExceedingLag(lags []GroupLag, threshold int64) []GroupLag {
+	var exceeding []GroupLag
+	for _, lag := range lags {
+		if lag.TotalLag > threshold {
+			exceeding = append(exceeding, lag)
+		}
+	}
+
+	return exceeding
+}
+
+// MapLagToTableRows creates the table rows of the consumer group lag
+func MapLagToTableRows(lags []GroupLag) []GroupLagRow {
+	rows := make([]GroupLagRow, len(lags))
+	for i, lag := range lags {
+		rows[i] = GroupLagRow(lag)
+	}
+
+	return rows
+}
+
+// WritePrometheus prints the consumer group lag in the Prometheus text exposition format,
+// labelled with the name of the Kafka instance
+func WritePrometheus(w io.Writer, instanceName string, lags []GroupLag) error {
+	metrics := []struct {
+		name  string
+		help  string
+		value func(GroupLag) int64
+	}{
+		{totalLagMetric, "Total offset lag of the consumer group on the topic.", func(l GroupLag) int64 { return l.TotalLag }},
+		{maxLagMetric, "Largest offset lag of a partition of the topic for the consumer group.", func(l GroupLag) int64 { return l.MaxLag }},
+	}
+
+	var b strings.Builder
+	for _, metric := range metrics {
+		fmt.Fprintf(&b, "# HELP %v %v\n", metric.name, metric.help)
+		fmt.Fprintf(&b, "# TYPE %v gauge\n", metric.name)
+		for _, lag := range lags {
+			fmt.Fprintf(&b, "%v{kafka_instance=\"%v\",group=\"%v\",topic=\"%v\"} %v\n",
+				metric.name, escapeLabelValue(instanceName), escapeLabelValue(lag.GroupID), escapeLabelValue(lag.Topic), metric.value(lag))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue escapes a label value as required by the Prometheus text format
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
@@ -0,0 +1,101 @@
+package groupcmdutil
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func newConsumerGroup(id string, consumers ...kafkainstanceclient.Consumer) kafkainstanceclient.ConsumerGroup {
+	group := kafkainstanceclient.NewConsumerGroup(id, consumers)
+	return *group
+}
+
+func newConsumer(topic string, partition int32, lag int32) kafkainstanceclient.Consumer {
+	consumer := kafkainstanceclient.NewConsumer("", topic, partition, 0, lag)
+	return *consumer
+}
+
+func TestComputeLag(t *testing.T) {
+	groups := []kafkainstanceclient.ConsumerGroup{
+		newConsumerGroup("payments",
+			newConsumer("payments", 0, 5),
+		),
+		newConsumerGroup("orders",
+			newConsumer("orders", 0, 10),
+			newConsumer("orders", 1, 30),
+			newConsumer("audit", 0, 0),
+			newConsumer("", -1, 0),
+		),
+	}
+
+	tests := []struct {
+		name  string
+		topic string
+		want  []GroupLag
+	}{
+		{
+			name:  "Should aggregate the lag by group and topic",
+			topic: "",
+			want: []GroupLag{
+				{GroupID: "orders", Topic: "audit", Partitions: 1, TotalLag: 0, MaxLag: 0},
+				{GroupID: "orders", Topic: "orders", Partitions: 2, TotalLag: 40, MaxLag: 30},
+				{GroupID: "payments", Topic: "payments", Partitions: 1, TotalLag: 5, MaxLag: 5},
+			},
+		},
+		{
+			name:  "Should only aggregate the lag on the topic",
+			topic: "orders",
+			want: []GroupLag{
+				{GroupID: "orders", Topic: "orders", Partitions: 2, TotalLag: 40, MaxLag: 30},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ComputeLag(groups, tt.topic); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ComputeLag() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExceedingLag(t *testing.T) {
+	lags := []GroupLag{
+		{GroupID: "orders", Topic: "orders", TotalLag: 40},
+		{GroupID: "payments", Topic: "payments", TotalLag: 5},
+	}
+
+	if got := ExceedingLag(lags, 5); len(got) != 1 || got[0].GroupID != "orders" {
+		t.Errorf("ExceedingLag() = %+v, want only the orders group", got)
+	}
+	if got := ExceedingLag(lags, 40); len(got) != 0 {
+		t.Errorf("ExceedingLag() = %+v, want none", got)
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	lags := []GroupLag{
+		{GroupID: `my"group`, Topic: "orders", Partitions: 2, TotalLag: 40, MaxLag: 30},
+	}
+
+	var b bytes.Buffer
+	if err := WritePrometheus(&b, "my-kafka", lags); err != nil {
+		t.Fatalf("WritePrometheus() error = %v", err)
+	}
+
+	want := `# HELP rhoas_kafka_consumergroup_lag Total offset lag of the consumer group on the topic.
+# TYPE rhoas_kafka_consumergroup_lag gauge
+rhoas_kafka_consumergroup_lag{kafka_instance="my-kafka",group="my\"group",topic="orders"} 40
+# HELP rhoas_kafka_consumergroup_lag_max Largest offset lag of a partition of the topic for the consumer group.
+# TYPE rhoas_kafka_consumergroup_lag_max gauge
+rhoas_kafka_consumergroup_lag_max{kafka_instance="my-kafka",group="my\"group",topic="orders"} 30
+`
+	if got := b.String(); got != want {
+		t.Errorf("WritePrometheus() =\n%v\nwant\n%v", got, want)
+	}
+}
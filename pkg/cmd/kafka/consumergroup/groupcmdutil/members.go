@@ -0,0 +1,206 @@
+package groupcmdutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+// MemberClient identifies the client of a consumer group member
+type MemberClient struct {
+	ClientID   string
+	ClientHost string
+}
+
+// MemberReader reads the clients of the members of a consumer group from a Kafka instance
+type MemberReader interface {
+	// Clients returns the clients of the members of the consumer group by member ID
+	Clients(groupID string) (map[string]MemberClient, error)
+}
+
+type saramaMemberReader struct {
+	client sarama.Client
+}
+
+// NewMemberReader creates a MemberReader which uses the Kafka protocol
+func NewMemberReader(client sarama.Client) MemberReader {
+	return &saramaMemberReader{client: client}
+}
+
+func (r *saramaMemberReader) Clients(groupID string) (map[string]MemberClient, error) {
+	// the cluster admin is not closed, as it would close the client
+	admin, err := sarama.NewClusterAdminFromClient(r.client)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := admin.DescribeConsumerGroups([]string{groupID})
+	if err != nil {
+		return nil, err
+	}
+
+	clients := map[string]MemberClient{}
+	for _, group := range groups {
+		if group.Err != sarama.ErrNoError {
+			return nil, group.Err
+		}
+		for memberID, member := range group.Members {
+			clients[memberID] = MemberClient{ClientID: member.ClientId, ClientHost: member.ClientHost}
+		}
+	}
+
+	return clients, nil
+}
+
+// TopicPartition identifies a partition of a topic
+type TopicPartition struct {
+	Topic     string `json:"topic" yaml:"topic"`
+	Partition int32  `json:"partition" yaml:"partition"`
+}
+
+// MemberAssignment contains the partitions assigned to a member of a consumer group
+type MemberAssignment struct {
+	MemberID   string           `json:"memberId" yaml:"memberId"`
+	ClientID   string           `json:"clientId,omitempty" yaml:"clientId,omitempty"`
+	ClientHost string           `json:"clientHost,omitempty" yaml:"clientHost,omitempty"`
+	Partitions []TopicPartition `json:"partitions" yaml:"partitions"`
+	Lag        int64            `json:"lag" yaml:"lag"`
+}
+
+// GroupMembers is the member-centric view of a consumer group
+type GroupMembers struct {
+	GroupID string             `json:"groupId" yaml:"groupId"`
+	State   string             `json:"state,omitempty" yaml:"state,omitempty"`
+	Members []MemberAssignment `json:"members" yaml:"members"`
+	// Unassigned contains the partitions with committed offsets but without an active consumer
+	Unassigned []TopicPartition `json:"unassignedPartitions" yaml:"unassignedPartitions"`
+}
+
+// NewGroupMembers groups the partitions of the consumer group by member.
+// The clients of the members are set when they are known
+func NewGroupMembers(group *kafkainstanceclient.ConsumerGroup, clients map[string]MemberClient) *GroupMembers {
+	view := &GroupMembers{
+		GroupID:    group.GetGroupId(),
+		State:      group.GetState(),
+		Members:    []MemberAssignment{},
+		Unassigned: []TopicPartition{},
+	}
+
+	members := map[string]*MemberAssignment{}
+	member := func(id string) *MemberAssignment {
+		m, ok := members[id]
+		if !ok {
+			m = &MemberAssignment{MemberID: id, Partitions: []TopicPartition{}}
+			if client, ok := clients[id]; ok {
+				m.ClientID = client.ClientID
+				m.ClientHost = client.ClientHost
+			}
+			members[id] = m
+		}
+		return m
+	}
+
+	for _, consumer := range group.GetConsumers() {
+		memberID := consumer.GetMemberId()
+		if memberID == "" {
+			if consumer.GetPartition() >= 0 {
+				view.Unassigned = append(view.Unassigned, TopicPartition{Topic: consumer.GetTopic(), Partition: consumer.GetPartition()})
+			}
+			continue
+		}
+
+		m := member(memberID)
+		// members without partitions are listed with partition -1
+		if consumer.GetPartition() < 0 {
+			continue
+		}
+		m.Partitions = append(m.Partitions, TopicPartition{Topic: consumer.GetTopic(), Partition: consumer.GetPartition()})
+		m.Lag += int64(consumer.GetLag())
+	}
+
+	// members which are connected without being assigned any partitions
+	for id := range clients {
+		member(id)
+	}
+
+	for _, m := range members {
+		sortTopicPartitions(m.Partitions)
+		view.Members = append(view.Members, *m)
+	}
+	sort.Slice(view.Members, func(i, j int) bool {
+		return view.Members[i].MemberID < view.Members[j].MemberID
+	})
+	sortTopicPartitions(view.Unassigned)
+
+	return view
+}
+
+// MemberRow is the table representation of a consumer group member
+type MemberRow struct {
+	MemberID   string `header:"Member ID"`
+	ClientID   string `header:"Client ID"`
+	ClientHost string `header:"Host"`
+	Count      int    `header:"Partitions"`
+	Assignment string `header:"Assignment"`
+	Lag        int64  `header:"Offset lag"`
+}
+
+// MapMembersToTableRows creates the table rows of the members of a consumer group
+func MapMembersToTableRows(members []MemberAssignment) []MemberRow {
+	rows := make([]MemberRow, len(members))
+	for i, m := range members {
+		rows[i] = MemberRow{
+			MemberID:   m.MemberID,
+			ClientID:   ValueOrDash(m.ClientID),
+			ClientHost: ValueOrDash(m.ClientHost),
+			Count:      len(m.Partitions),
+			Assignment: FormatTopicPartitions(m.Partitions),
+			Lag:        m.Lag,
+		}
+	}
+
+	return rows
+}
+
+// FormatTopicPartitions formats partitions grouped by topic, such as "orders: 0,1 audit: 2"
+func FormatTopicPartitions(partitions []TopicPartition) string {
+	if len(partitions) == 0 {
+		return "-"
+	}
+
+	var topics []string
+	byTopic := map[string][]string{}
+	for _, p := range partitions {
+		if _, ok := byTopic[p.Topic]; !ok {
+			topics = append(topics, p.Topic)
+		}
+		byTopic[p.Topic] = append(byTopic[p.Topic], fmt.Sprint(p.Partition))
+	}
+
+	formatted := make([]string, len(topics))
+	for i, topic := range topics {
+		formatted[i] = fmt.Sprintf("%v: %v", topic, strings.Join(byTopic[topic], ","))
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+func sortTopicPartitions(partitions []TopicPartition) {
+	sort.Slice(partitions, func(i, j int) bool {
+		if partitions[i].Topic != partitions[j].Topic {
+			return partitions[i].Topic < partitions[j].Topic
+		}
+		return partitions[i].Partition < partitions[j].Partition
+	})
+}
+
+// ValueOrDash returns the value, or a dash when the value is empty
+func ValueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
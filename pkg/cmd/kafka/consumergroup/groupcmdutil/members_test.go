@@ -0,0 +1,80 @@
+package groupcmdutil
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func newAssignedConsumer(memberID string, topic string, partition int32, lag int32) kafkainstanceclient.Consumer {
+	consumer := kafkainstanceclient.NewConsumer("my-group", topic, partition, 0, lag)
+	if memberID != "" {
+		consumer.SetMemberId(memberID)
+	}
+	return *consumer
+}
+
+func TestNewGroupMembers(t *testing.T) {
+	group := newConsumerGroup("my-group",
+		newAssignedConsumer("consumer-b", "orders", 1, 20),
+		newAssignedConsumer("consumer-a", "orders", 2, 5),
+		newAssignedConsumer("consumer-a", "audit", 0, 0),
+		newAssignedConsumer("consumer-a", "orders", 0, 10),
+		newAssignedConsumer("", "payments", 0, 100),
+	)
+	group.SetState("Stable")
+
+	clients := map[string]MemberClient{
+		"consumer-a": {ClientID: "app-1", ClientHost: "/10.0.0.1"},
+		"consumer-c": {ClientID: "app-3", ClientHost: "/10.0.0.3"},
+	}
+
+	members := NewGroupMembers(&group, clients)
+
+	want := []MemberAssignment{
+		{
+			MemberID:   "consumer-a",
+			ClientID:   "app-1",
+			ClientHost: "/10.0.0.1",
+			Partitions: []TopicPartition{{"audit", 0}, {"orders", 0}, {"orders", 2}},
+			Lag:        15,
+		},
+		{MemberID: "consumer-b", Partitions: []TopicPartition{{"orders", 1}}, Lag: 20},
+		{MemberID: "consumer-c", ClientID: "app-3", ClientHost: "/10.0.0.3", Partitions: []TopicPartition{}},
+	}
+
+	if !reflect.DeepEqual(members.Members, want) {
+		t.Errorf("NewGroupMembers() members = %+v, want %+v", members.Members, want)
+	}
+	if !reflect.DeepEqual(members.Unassigned, []TopicPartition{{"payments", 0}}) {
+		t.Errorf("NewGroupMembers() unassigned = %+v, want payments partition 0", members.Unassigned)
+	}
+	if members.State != "Stable" {
+		t.Errorf("NewGroupMembers() state = %v, want Stable", members.State)
+	}
+}
+
+func TestFormatTopicPartitions(t *testing.T) {
+	tests := []struct {
+		name       string
+		partitions []TopicPartition
+		want       string
+	}{
+		{name: "Should format no partitions", partitions: nil, want: "-"},
+		{
+			name:       "Should group partitions by topic",
+			partitions: []TopicPartition{{"audit", 0}, {"orders", 0}, {"orders", 2}},
+			want:       "audit: 0 orders: 0,2",
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTopicPartitions(tt.partitions); got != tt.want {
+				t.Errorf("FormatTopicPartitions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
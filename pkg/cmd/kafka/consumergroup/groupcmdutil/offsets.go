@@ -0,0 +1,36 @@
+package groupcmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// PartitionOffsets are the committed offset of a consumer group on a partition
+// and the end offset of the partition log
+type PartitionOffsets struct {
+	Topic        string `json:"topic"`
+	Partition    int32  `json:"partition"`
+	Offset       int64  `json:"offset"`
+	LogEndOffset int64  `json:"logEndOffset"`
+}
+
+// ReadPartitionOffsets reads the offsets of a consumer group from the response body of the consumer group API.
+// The SDK decodes offsets as float32 numbers, which cannot represent offsets above 2^24 exactly,
+// so the offsets are decoded from the JSON numbers of the response body instead
+func ReadPartitionOffsets(httpRes *http.Response) ([]PartitionOffsets, error) {
+	body, err := ioutil.ReadAll(httpRes.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var group struct {
+		Consumers []PartitionOffsets `json:"consumers"`
+	}
+	if err = json.Unmarshal(body, &group); err != nil {
+		return nil, fmt.Errorf("could not read the offsets of the consumer group: %w", err)
+	}
+
+	return group.Consumers, nil
+}
@@ -0,0 +1,34 @@
+package groupcmdutil
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadPartitionOffsets(t *testing.T) {
+	body := `{
+  "groupId": "my-group",
+  "consumers": [
+    {"groupId": "my-group", "topic": "orders", "partition": 0, "memberId": "", "offset": 16777217, "lag": 2, "logEndOffset": 16777219},
+    {"groupId": "my-group", "topic": "", "partition": -1, "memberId": "member-1", "offset": 0, "lag": 0}
+  ]
+}`
+	httpRes := &http.Response{Body: ioutil.NopCloser(strings.NewReader(body))}
+
+	got, err := ReadPartitionOffsets(httpRes)
+	if err != nil {
+		t.Fatalf("ReadPartitionOffsets() error = %v", err)
+	}
+
+	// offsets above 2^24 cannot be represented exactly as float32 numbers
+	want := []PartitionOffsets{
+		{Topic: "orders", Partition: 0, Offset: 16777217, LogEndOffset: 16777219},
+		{Topic: "", Partition: -1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadPartitionOffsets() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,226 @@
+package groupcmdutil
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// TimestampOffsetLayout is the layout of the value of the timestamp offset
+const TimestampOffsetLayout = "2006-01-02T15:04:05-07:00"
+
+// OffsetReader reads the offsets of partition logs from a Kafka instance
+type OffsetReader interface {
+	// LogStartOffset returns the offset of the first message in the partition log
+	LogStartOffset(topic string, partition int32) (int64, error)
+	// OffsetForTime returns the offset of the first message produced at or after the time,
+	// or -1 when there are no such messages
+	OffsetForTime(topic string, partition int32, t time.Time) (int64, error)
+}
+
+type saramaOffsetReader struct {
+	client sarama.Client
+}
+
+// NewOffsetReader creates an OffsetReader which uses the Kafka protocol
+func NewOffsetReader(client sarama.Client) OffsetReader {
+	return &saramaOffsetReader{client: client}
+}
+
+func (r *saramaOffsetReader) LogStartOffset(topic string, partition int32) (int64, error) {
+	return r.client.GetOffset(topic, partition, sarama.OffsetOldest)
+}
+
+func (r *saramaOffsetReader) OffsetForTime(topic string, partition int32, t time.Time) (int64, error) {
+	return r.client.GetOffset(topic, partition, t.UnixNano()/int64(time.Millisecond))
+}
+
+// PartitionReset is the reset of the committed offset of a consumer group on a partition
+type PartitionReset struct {
+	Topic         string `json:"topic" yaml:"topic"`
+	Partition     int32  `json:"partition" yaml:"partition"`
+	CurrentOffset int64  `json:"currentOffset" yaml:"currentOffset"`
+	LogEndOffset  int64  `json:"logEndOffset" yaml:"logEndOffset"`
+	// TargetOffset is nil when it cannot be computed without reading the partition log
+	TargetOffset *int64 `json:"targetOffset,omitempty" yaml:"targetOffset,omitempty"`
+	// Clamped is true when the shifted offset was outside the partition log
+	// and the target offset was moved to the start or the end of the log
+	Clamped bool `json:"clamped,omitempty" yaml:"clamped,omitempty"`
+}
+
+// Lag returns the offset lag of the partition after the reset
+func (r *PartitionReset) Lag() (int64, bool) {
+	if r.TargetOffset == nil {
+		return 0, false
+	}
+	return r.LogEndOffset - *r.TargetOffset, true
+}
+
+// ResetTimestamp returns the time to which the offsets are reset by the timestamp and duration offsets
+func ResetTimestamp(offset string, value string, now time.Time) (time.Time, error) {
+	if offset == OffsetDuration {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(d), nil
+	}
+
+	return time.Parse(TimestampOffsetLayout, value)
+}
+
+// TopicsToReset returns the topics on which the consumer group has committed offsets, sorted by name
+func TopicsToReset(offsets []PartitionOffsets) []string {
+	seen := map[string]bool{}
+	var topics []string
+	for _, offset := range offsets {
+		if offset.Partition < 0 || seen[offset.Topic] {
+			continue
+		}
+		seen[offset.Topic] = true
+		topics = append(topics, offset.Topic)
+	}
+	sort.Strings(topics)
+
+	return topics
+}
+
+// PlanReset computes the target offset of each partition of the consumer group which is reset.
+// All topics are reset when topic is empty, and all partitions when partitions is empty.
+// When reader is nil, the target offsets which depend on the partition logs are not computed
+// nolint:gocyclo
+func PlanReset(offsets []PartitionOffsets, topic string, partitions []int32, offset string, value string, now time.Time, reader OffsetReader) ([]PartitionReset, error) {
+	selected := map[int32]bool{}
+	for _, p := range partitions {
+		selected[p] = true
+	}
+
+	var resets []PartitionReset
+	for _, partitionOffsets := range offsets {
+		if partitionOffsets.Partition < 0 || (topic != "" && partitionOffsets.Topic != topic) {
+			continue
+		}
+		if len(selected) > 0 && !selected[partitionOffsets.Partition] {
+			continue
+		}
+
+		reset := PartitionReset{
+			Topic:         partitionOffsets.Topic,
+			Partition:     partitionOffsets.Partition,
+			CurrentOffset: partitionOffsets.Offset,
+			LogEndOffset:  partitionOffsets.LogEndOffset,
+		}
+
+		var target int64
+		switch offset {
+		case OffsetLatest:
+			target = reset.LogEndOffset
+		case OffsetAbsolute:
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			target = n
+		case OffsetShiftBy:
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			// offsets cannot be moved outside the partition log
+			start := int64(0)
+			if reader != nil {
+				if s, err := reader.LogStartOffset(reset.Topic, reset.Partition); err == nil {
+					start = s
+				}
+			}
+			target = clamp(reset.CurrentOffset+n, start, reset.LogEndOffset)
+			reset.Clamped = target != reset.CurrentOffset+n
+		case OffsetEarliest:
+			if reader == nil {
+				break
+			}
+			start, err := reader.LogStartOffset(reset.Topic, reset.Partition)
+			if err != nil {
+				return nil, err
+			}
+			target = start
+		case OffsetTimestamp, OffsetDuration:
+			if reader == nil {
+				break
+			}
+			t, err := ResetTimestamp(offset, value, now)
+			if err != nil {
+				return nil, err
+			}
+			n, err := reader.OffsetForTime(reset.Topic, reset.Partition, t)
+			if err != nil {
+				return nil, err
+			}
+			// without messages after the time, the offset is reset to the end of the log
+			if n < 0 {
+				n = reset.LogEndOffset
+			}
+			target = n
+		default:
+			return nil, fmt.Errorf("unknown offset %q", offset)
+		}
+
+		if reader != nil || (offset != OffsetEarliest && offset != OffsetTimestamp && offset != OffsetDuration) {
+			reset.TargetOffset = &target
+		}
+
+		resets = append(resets, reset)
+	}
+
+	sort.Slice(resets, func(i, j int) bool {
+		if resets[i].Topic != resets[j].Topic {
+			return resets[i].Topic < resets[j].Topic
+		}
+		return resets[i].Partition < resets[j].Partition
+	})
+
+	return resets, nil
+}
+
+// PartitionResetRow is the table representation of the reset of a partition
+type PartitionResetRow struct {
+	Topic         string `header:"Topic"`
+	Partition     int32  `header:"Partition"`
+	CurrentOffset int64  `header:"Current offset"`
+	TargetOffset  string `header:"Target offset"`
+	Lag           string `header:"Offset lag after reset"`
+}
+
+// MapResetsToTableRows creates the table rows which preview the reset of the partitions
+func MapResetsToTableRows(resets []PartitionReset) []PartitionResetRow {
+	rows := make([]PartitionResetRow, len(resets))
+	for i := range resets {
+		row := PartitionResetRow{
+			Topic:         resets[i].Topic,
+			Partition:     resets[i].Partition,
+			CurrentOffset: resets[i].CurrentOffset,
+			TargetOffset:  "-",
+			Lag:           "-",
+		}
+		if lag, ok := resets[i].Lag(); ok {
+			row.TargetOffset = fmt.Sprint(*resets[i].TargetOffset)
+			row.Lag = fmt.Sprint(lag)
+		}
+		rows[i] = row
+	}
+
+	return rows
+}
+
+func clamp(n, min, max int64) int64 {
+	if n < min {
+		return min
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
@@ -0,0 +1,138 @@
+package groupcmdutil
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeOffsetReader returns the same log start offset for all partitions,
+// and the offset of a message every second after the log start time
+type fakeOffsetReader struct {
+	start     int64
+	startTime time.Time
+}
+
+func (r *fakeOffsetReader) LogStartOffset(topic string, partition int32) (int64, error) {
+	return r.start, nil
+}
+
+func (r *fakeOffsetReader) OffsetForTime(topic string, partition int32, t time.Time) (int64, error) {
+	if t.Before(r.startTime) {
+		return r.start, nil
+	}
+	return r.start + int64(t.Sub(r.startTime)/time.Second), nil
+}
+
+func newCommittedOffset(topic string, partition int32, offset int64, logEndOffset int64) PartitionOffsets {
+	return PartitionOffsets{Topic: topic, Partition: partition, Offset: offset, LogEndOffset: logEndOffset}
+}
+
+func TestPlanReset(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	consumers := []PartitionOffsets{
+		newCommittedOffset("orders", 1, 80, 100),
+		newCommittedOffset("orders", 0, 50, 100),
+		newCommittedOffset("audit", 0, 5, 10),
+		newCommittedOffset("", -1, 0, 0),
+	}
+	reader := &fakeOffsetReader{start: 20, startTime: now.Add(-time.Hour)}
+
+	tests := []struct {
+		name       string
+		topic      string
+		partitions []int32
+		offset     string
+		value      string
+		reader     OffsetReader
+		// target offsets by partition of the orders topic, -1 when not computed
+		want []int64
+		// partitions of the orders topic whose shifted offset was clamped
+		wantClamped []bool
+	}{
+		{
+			name:   "Should reset to the end of the log",
+			topic:  "orders",
+			offset: OffsetLatest,
+			want:   []int64{100, 100},
+		},
+		{
+			name:   "Should shift the offsets within the log",
+			topic:  "orders",
+			offset: OffsetShiftBy,
+			value:  "-40",
+			reader: reader,
+			want:   []int64{20, 40},
+			// partition 0 is shifted to offset 10, before the start of the log
+			wantClamped: []bool{true, false},
+		},
+		{
+			name:        "Should not shift the offsets past the end of the log",
+			topic:       "orders",
+			offset:      OffsetShiftBy,
+			value:       "30",
+			want:        []int64{80, 100},
+			wantClamped: []bool{false, true},
+		},
+		{
+			name:   "Should reset to the offset of a duration ago",
+			topic:  "orders",
+			offset: OffsetDuration,
+			value:  "-30m",
+			reader: reader,
+			want:   []int64{1820, 1820},
+		},
+		{
+			name:   "Should not compute the earliest offset without the partition logs",
+			topic:  "orders",
+			offset: OffsetEarliest,
+			want:   []int64{-1, -1},
+		},
+		{
+			name:       "Should only reset the partitions",
+			topic:      "orders",
+			partitions: []int32{1},
+			offset:     OffsetAbsolute,
+			value:      "90",
+			want:       []int64{90},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			resets, err := PlanReset(consumers, tt.topic, tt.partitions, tt.offset, tt.value, now, tt.reader)
+			if err != nil {
+				t.Fatalf("PlanReset() error = %v", err)
+			}
+			if len(resets) != len(tt.want) {
+				t.Fatalf("PlanReset() = %+v, want %v partitions", resets, len(tt.want))
+			}
+
+			for i, reset := range resets {
+				got := int64(-1)
+				if reset.TargetOffset != nil {
+					got = *reset.TargetOffset
+				}
+				if got != tt.want[i] {
+					t.Errorf("PlanReset() partition %v target offset = %v, want %v", reset.Partition, got, tt.want[i])
+				}
+				if tt.wantClamped != nil && reset.Clamped != tt.wantClamped[i] {
+					t.Errorf("PlanReset() partition %v clamped = %v, want %v", reset.Partition, reset.Clamped, tt.wantClamped[i])
+				}
+			}
+		})
+	}
+
+	t.Run("Should reset all topics of the consumer group", func(t *testing.T) {
+		resets, err := PlanReset(consumers, "", nil, OffsetLatest, "", now, nil)
+		if err != nil {
+			t.Fatalf("PlanReset() error = %v", err)
+		}
+		if len(resets) != 3 || resets[0].Topic != "audit" || resets[1].Partition != 0 {
+			t.Errorf("PlanReset() = %+v, want partitions sorted by topic", resets)
+		}
+		if lag, _ := resets[0].Lag(); lag != 0 {
+			t.Errorf("Lag() = %v, want 0", lag)
+		}
+	})
+}
@@ -1,6 +1,10 @@
 package groupcmdutil
 
 import (
+	"regexp"
+	"sort"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 )
 
@@ -10,9 +14,11 @@ const (
 	OffsetEarliest  = "earliest"
 	OffsetTimestamp = "timestamp"
 	OffsetLatest    = "latest"
+	OffsetShiftBy   = "shift-by"
+	OffsetDuration  = "duration"
 )
 
-var ValidOffsets = []string{OffsetAbsolute, OffsetEarliest, OffsetTimestamp, OffsetLatest}
+var ValidOffsets = []string{OffsetAbsolute, OffsetEarliest, OffsetTimestamp, OffsetLatest, OffsetShiftBy, OffsetDuration}
 
 // GetPartitionsWithLag returns the number of partitions having lag for a consumer group
 func GetPartitionsWithLag(consumers []kafkainstanceclient.Consumer) (partitionsWithLag int) {
@@ -42,3 +48,41 @@ func GetUnassignedPartitions(consumers []kafkainstanceclient.Consumer) (unassign
 	}
 	return unassignedPartitions
 }
+
+// HasActiveMembers returns true when members are connected to the consumer group
+func HasActiveMembers(consumers []kafkainstanceclient.Consumer) bool {
+	for _, consumer := range consumers {
+		if consumer.GetMemberId() != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterConsumerGroups returns the IDs of the consumer groups which fully match the pattern, if set.
+// When emptyOnly is set, consumer groups with active members are excluded.
+// The IDs are sorted
+func FilterConsumerGroups(localizer localize.Localizer, groups []kafkainstanceclient.ConsumerGroup, pattern string, emptyOnly bool) ([]string, error) {
+	var re *regexp.Regexp
+	if pattern != "" {
+		var err error
+		if re, err = regexp.Compile("^(?:" + pattern + ")$"); err != nil {
+			return nil, localizer.MustLocalizeError("kafka.consumerGroup.common.error.invalidPattern", localize.NewEntry("Pattern", pattern), localize.NewEntry("ErrorMessage", err))
+		}
+	}
+
+	var ids []string
+	for _, group := range groups {
+		if re != nil && !re.MatchString(group.GetGroupId()) {
+			continue
+		}
+		if emptyOnly && HasActiveMembers(group.GetConsumers()) {
+			continue
+		}
+		ids = append(ids, group.GetGroupId())
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
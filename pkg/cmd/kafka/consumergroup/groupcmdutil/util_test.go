@@ -0,0 +1,42 @@
+package groupcmdutil
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func TestFilterConsumerGroups(t *testing.T) {
+	groups := []kafkainstanceclient.ConsumerGroup{
+		newConsumerGroup("test-b", newAssignedConsumer("", "orders", 0, 0)),
+		newConsumerGroup("test-a", newAssignedConsumer("consumer-1", "orders", 0, 0)),
+		newConsumerGroup("my-test", newAssignedConsumer("", "orders", 0, 0)),
+	}
+
+	tests := []struct {
+		name      string
+		pattern   string
+		emptyOnly bool
+		want      []string
+		wantErr   bool
+	}{
+		{name: "Should return all consumer groups", want: []string{"my-test", "test-a", "test-b"}},
+		{name: "Should match the full consumer group ID", pattern: "test-.*", want: []string{"test-a", "test-b"}},
+		{name: "Should exclude consumer groups with active members", pattern: "test-.*", emptyOnly: true, want: []string{"test-b"}},
+		{name: "Should fail for an invalid pattern", pattern: "test-(", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FilterConsumerGroups(validator.Localizer, groups, tt.pattern, tt.emptyOnly)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FilterConsumerGroups() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterConsumerGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
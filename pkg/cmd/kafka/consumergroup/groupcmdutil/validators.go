@@ -3,6 +3,7 @@ package groupcmdutil
 import (
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
@@ -22,11 +23,12 @@ func (v *Validator) ValidateOffset(offset string) error {
 		return nil
 	}
 
-	return flagutil.InvalidValueError("output", v, ValidOffsets...)
+	return flagutil.InvalidValueError("offset", offset, ValidOffsets...)
 }
 
-// ValidateOffsetValue validates value for timestamp and absolute offset
-// value for absolute offset should be integer and timestamp should be in format "yyyy-MM-dd'T'HH:mm:ssz"
+// ValidateOffsetValue validates value for timestamp, absolute, shift-by and duration offset
+// value for absolute and shift-by offset should be integer, timestamp should be in format "yyyy-MM-dd'T'HH:mm:ssz"
+// and duration should be a negative duration such as "-1h30m"
 func (v *Validator) ValidateOffsetValue(offset string, value string) error {
 	offsetValueTmplPair := localize.NewEntry("Value", value)
 	switch offset {
@@ -40,6 +42,14 @@ func (v *Validator) ValidateOffsetValue(offset string, value string) error {
 			offsetValueTmplPair := localize.NewEntry("Value", value)
 			return v.Localizer.MustLocalizeError("kafka.consumerGroup.resetOffset.error.invalidAbsoluteOffset", offsetValueTmplPair)
 		}
+	case OffsetShiftBy:
+		if _, parseErr := strconv.ParseInt(value, 10, 64); parseErr != nil {
+			return v.Localizer.MustLocalizeError("kafka.consumerGroup.resetOffset.error.invalidShiftByOffset", offsetValueTmplPair)
+		}
+	case OffsetDuration:
+		if d, parseErr := time.ParseDuration(value); parseErr != nil || d >= 0 {
+			return v.Localizer.MustLocalizeError("kafka.consumerGroup.resetOffset.error.invalidDurationOffset", offsetValueTmplPair)
+		}
 	}
 	return nil
 }
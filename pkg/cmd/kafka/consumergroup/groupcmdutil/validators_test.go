@@ -152,6 +152,38 @@ func TestValidateOffsetValue(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should be valid when value for shift-by offset is a negative integer",
+			args: args{
+				offset: "shift-by",
+				value:  "-100",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should throw an error when value for shift-by offset is not an integer",
+			args: args{
+				offset: "shift-by",
+				value:  "1.5",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should be valid when value for duration offset is a negative duration",
+			args: args{
+				offset: "duration",
+				value:  "-1h30m",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should throw an error when value for duration offset is a positive duration",
+			args: args{
+				offset: "duration",
+				value:  "1h",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,242 @@
+package lag
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/groupcmdutil"
+	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+var validOutputFormats = append(append([]string{}, flagutil.ValidOutputFormats...), groupcmdutil.PrometheusFormat)
+
+type options struct {
+	kafkaID      string
+	outputFormat string
+	topic        string
+	search       string
+	watch        bool
+	interval     time.Duration
+	threshold    int64
+	hasThreshold bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewLagConsumerGroupCommand gets a new command for showing the offset lag of consumer groups.
+func NewLagConsumerGroupCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "lag",
+		Short:   opts.localizer.MustLocalize("kafka.consumerGroup.lag.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.consumerGroup.lag.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.consumerGroup.lag.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			opts.hasThreshold = cmd.Flags().Changed("threshold")
+			if opts.hasThreshold && opts.threshold < 0 {
+				return opts.localizer.MustLocalizeError("kafka.consumerGroup.lag.error.invalidThreshold", localize.NewEntry("Threshold", opts.threshold))
+			}
+
+			if opts.interval <= 0 {
+				return opts.localizer.MustLocalizeError("kafka.consumerGroup.lag.error.invalidInterval", localize.NewEntry("Interval", opts.interval))
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runCmd(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.StringVarP(&opts.outputFormat, "output", "o", "", flagutil.FlagDescription(opts.localizer, "kafka.consumerGroup.lag.flag.output.description", validOutputFormats...))
+	flags.StringVar(&opts.topic, "topic", "", opts.localizer.MustLocalize("kafka.consumerGroup.lag.flag.topic.description"))
+	flags.StringVar(&opts.search, "search", "", opts.localizer.MustLocalize("kafka.consumerGroup.list.flag.search"))
+	flags.BoolVar(&opts.watch, "watch", false, opts.localizer.MustLocalize("kafka.consumerGroup.lag.flag.watch.description"))
+	flags.DurationVar(&opts.interval, "interval", 10*time.Second, opts.localizer.MustLocalize("kafka.consumerGroup.lag.flag.interval.description"))
+	flags.Int64Var(&opts.threshold, "threshold", 0, opts.localizer.MustLocalize("kafka.consumerGroup.lag.flag.threshold.description"))
+
+	_ = cmd.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output", validOutputFormats)
+
+	return cmd
+}
+
+func runCmd(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	if !opts.watch {
+		lags, err := getLag(opts, api, kafkaInstance)
+		if err != nil {
+			return err
+		}
+
+		if err = printLag(opts, kafkaInstance, lags); err != nil {
+			return err
+		}
+
+		return checkThreshold(opts, lags)
+	}
+
+	// refresh the lag until interrupted
+	ctx, stop := signal.NotifyContext(opts.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+
+	for {
+		lags, err := getLag(opts, api, kafkaInstance)
+		if err != nil {
+			return err
+		}
+
+		if opts.outputFormat == dump.EmptyFormat {
+			opts.Logger.Info(color.Bold(time.Now().Format(time.RFC3339)))
+		}
+		if err = printLag(opts, kafkaInstance, lags); err != nil {
+			return err
+		}
+
+		// keep watching when the threshold is exceeded, so that the lag can be seen to recover
+		if err = checkThreshold(opts, lags); err != nil {
+			opts.Logger.Info(color.Error(err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func getLag(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) ([]groupcmdutil.GroupLag, error) {
+	groups, httpRes, err := groupcmdutil.ListAllConsumerGroups(opts.Context, api, opts.topic, opts.search)
+	if err != nil {
+		if httpRes == nil {
+			return nil, err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "list")
+
+		switch httpRes.StatusCode {
+		case http.StatusUnauthorized:
+			return nil, opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return nil, opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return nil, opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return nil, opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return nil, err
+		}
+	}
+
+	return groupcmdutil.ComputeLag(groups, opts.topic), nil
+}
+
+func printLag(opts *options, kafkaInstance *kafkamgmtclient.KafkaRequest, lags []groupcmdutil.GroupLag) error {
+	switch opts.outputFormat {
+	case dump.EmptyFormat:
+		if len(lags) == 0 {
+			opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.list.log.info.noConsumerGroups", localize.NewEntry("InstanceName", kafkaInstance.GetName())))
+			return nil
+		}
+		opts.Logger.Info("")
+		dump.Table(opts.IO.Out, groupcmdutil.MapLagToTableRows(lags))
+		opts.Logger.Info("")
+	case groupcmdutil.PrometheusFormat:
+		return groupcmdutil.WritePrometheus(opts.IO.Out, kafkaInstance.GetName(), lags)
+	default:
+		if lags == nil {
+			lags = []groupcmdutil.GroupLag{}
+		}
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, lags)
+	}
+
+	return nil
+}
+
+// checkThreshold returns an error when the lag of a consumer group on a topic exceeds the threshold
+func checkThreshold(opts *options, lags []groupcmdutil.GroupLag) error {
+	if !opts.hasThreshold {
+		return nil
+	}
+
+	exceeding := groupcmdutil.ExceedingLag(lags, opts.threshold)
+	if len(exceeding) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(exceeding))
+	for i, lag := range exceeding {
+		names[i] = lag.GroupID + "/" + lag.Topic
+	}
+
+	return errors.New(opts.localizer.MustLocalizePlural("kafka.consumerGroup.lag.error.thresholdExceeded", len(exceeding),
+		localize.NewEntry("Count", len(exceeding)),
+		localize.NewEntry("Threshold", opts.threshold),
+		localize.NewEntry("Groups", strings.Join(names, ", ")),
+	))
+}
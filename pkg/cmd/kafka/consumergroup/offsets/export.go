@@ -0,0 +1,165 @@
+package offsets
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/groupcmdutil"
+	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+
+	"github.com/spf13/cobra"
+)
+
+type exportOptions struct {
+	kafkaID      string
+	id           string
+	outputFormat string
+	file         string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewExportCommand gets a new command for exporting the committed offsets of a consumer group.
+func NewExportCommand(f *factory.Factory) *cobra.Command {
+	opts := &exportOptions{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   opts.localizer.MustLocalize("kafka.consumerGroup.offsets.export.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.consumerGroup.offsets.export.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.consumerGroup.offsets.export.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if err = flagutil.ValidateOutput(opts.outputFormat); err != nil {
+				return err
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runExport(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.StringVarP(&opts.outputFormat, "output", "o", dump.JSONFormat, flagutil.FlagDescription(opts.localizer, "kafka.consumerGroup.offsets.export.flag.output.description", flagutil.ValidOutputFormats...))
+	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.consumerGroup.common.flag.id.description", localize.NewEntry("Action", "export the offsets of")))
+	flags.StringVar(&opts.file, "file", "", opts.localizer.MustLocalize("kafka.consumerGroup.offsets.export.flag.file.description"))
+
+	_ = cmd.MarkFlagRequired("id")
+
+	_ = cmd.RegisterFlagCompletionFunc("id", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidConsumerGroupIDs(f, toComplete)
+	})
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runExport(opts *exportOptions) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	consumerGroupData, httpRes, err := api.GroupsApi.GetConsumerGroupById(opts.Context, opts.id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		cgIDPair := localize.NewEntry("ID", opts.id)
+		kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+		operationTmplPair := localize.NewEntry("Operation", "view")
+
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.notFoundError", cgIDPair, kafkaNameTmplPair)
+		case http.StatusUnauthorized:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return err
+		}
+	}
+
+	offsets, err := groupcmdutil.ReadPartitionOffsets(httpRes)
+	if err != nil {
+		return err
+	}
+
+	backup := groupcmdutil.NewOffsetsBackup(consumerGroupData.GetGroupId(), offsets)
+	if len(backup.Offsets) == 0 {
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.offsets.export.error.noOffsets", localize.NewEntry("ID", opts.id))
+	}
+
+	if opts.file == "" {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, backup)
+	}
+
+	file, err := os.Create(opts.file)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err = dump.Formatted(file, opts.outputFormat, backup); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalizePlural("kafka.consumerGroup.offsets.export.log.info.exported", len(backup.Offsets),
+		localize.NewEntry("Count", len(backup.Offsets)),
+		localize.NewEntry("ID", opts.id),
+		localize.NewEntry("FilePath", opts.file),
+	))
+
+	return nil
+}
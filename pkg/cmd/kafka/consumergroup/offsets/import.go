@@ -0,0 +1,261 @@
+package offsets
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/groupcmdutil"
+	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"github.com/spf13/cobra"
+)
+
+type importOptions struct {
+	kafkaID     string
+	id          string
+	file        string
+	skipConfirm bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewImportCommand gets a new command for restoring the committed offsets of a consumer group.
+func NewImportCommand(f *factory.Factory) *cobra.Command {
+	opts := &importOptions{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "import",
+		Short:   opts.localizer.MustLocalize("kafka.consumerGroup.offsets.import.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.consumerGroup.offsets.import.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.consumerGroup.offsets.import.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if !opts.IO.CanPrompt() && !opts.skipConfirm {
+				return flagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runImport(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.StringVar(&opts.file, "file", "", opts.localizer.MustLocalize("kafka.consumerGroup.offsets.import.flag.file.description"))
+	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.consumerGroup.offsets.import.flag.id.description"))
+	flags.AddYes(&opts.skipConfirm)
+
+	_ = cmd.MarkFlagRequired("file")
+
+	_ = cmd.RegisterFlagCompletionFunc("id", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidConsumerGroupIDs(f, toComplete)
+	})
+
+	return cmd
+}
+
+// nolint:funlen
+func runImport(opts *importOptions) error {
+	backup, err := groupcmdutil.ReadOffsetsBackup(opts.file)
+	if err != nil {
+		return err
+	}
+
+	// the offsets can be restored to another consumer group
+	if opts.id == "" {
+		opts.id = backup.GroupID
+	}
+	if opts.id == "" {
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.offsets.import.error.noGroupID")
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	cgIDPair := localize.NewEntry("ID", opts.id)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	consumerGroupData, httpRes, err := api.GroupsApi.GetConsumerGroupById(opts.Context, opts.id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "view")
+
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			// a 404 is not only returned for missing consumer groups,
+			// so the consumer group is only new when it is not listed in the Kafka instance
+			exists, existsErr := consumerGroupExists(opts, api)
+			if existsErr != nil {
+				return existsErr
+			}
+			if exists {
+				return err
+			}
+			// offsets are committed to a new consumer group, which has no members
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.offsets.import.log.debug.newGroup", cgIDPair))
+		case http.StatusUnauthorized:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return err
+		}
+	}
+
+	// offsets can only be reset when no members are connected to the consumer group
+	if groupcmdutil.HasActiveMembers(consumerGroupData.GetConsumers()) {
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.offsets.import.error.activeMembers", cgIDPair)
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.consumerGroup.offsets.import.log.info.preview", len(backup.Offsets),
+		localize.NewEntry("Count", len(backup.Offsets)),
+		cgIDPair,
+	))
+	opts.Logger.Info()
+	dump.Table(opts.IO.Out, backup.Offsets)
+	opts.Logger.Info()
+
+	if !opts.skipConfirm {
+		var confirmImport bool
+		promptConfirmImport := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.input.confirmReset.message", cgIDPair),
+		}
+
+		if err = survey.AskOne(promptConfirmImport, &confirmImport); err != nil {
+			return err
+		}
+		if !confirmImport {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.debug.cancelledReset"))
+			return nil
+		}
+	}
+
+	// the offsets are reset with one request per topic and offset, so a failure
+	// reports the partitions which were already restored
+	applied := 0
+	for _, params := range backup.ResetParameters() {
+		topic := (*params.Topics)[0]
+		if err = resetOffsets(opts, api, params, kafkaInstance.GetName()); err != nil {
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.resetOffset.error.partialReset",
+				localize.NewEntry("Topic", topic.Topic),
+				localize.NewEntry("Partition", joinPartitions(topic.GetPartitions())),
+				localize.NewEntry("Applied", applied),
+				localize.NewEntry("Total", len(backup.Offsets)),
+				localize.NewEntry("ErrorMessage", err),
+			)
+		}
+		applied += len(topic.GetPartitions())
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("kafka.consumerGroup.offsets.import.log.info.imported", cgIDPair, kafkaNameTmplPair))
+
+	return nil
+}
+
+// resetOffsets resets the offsets of the partitions of a topic
+func resetOffsets(opts *importOptions, api *kafkainstanceclient.APIClient, params kafkainstanceclient.ConsumerGroupResetOffsetParameters, kafkaName string) error {
+	_, httpRes, err := api.GroupsApi.ResetConsumerGroupOffset(opts.Context, opts.id).ConsumerGroupResetOffsetParameters(params).Execute()
+	if httpRes != nil {
+		httpRes.Body.Close()
+	}
+
+	if err == nil || httpRes == nil {
+		return err
+	}
+
+	operationTmplPair := localize.NewEntry("Operation", "reset offset")
+
+	switch httpRes.StatusCode {
+	case http.StatusNotFound:
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.notFoundError", localize.NewEntry("ID", opts.id), localize.NewEntry("InstanceName", kafkaName))
+	case http.StatusUnauthorized:
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unauthorized", operationTmplPair)
+	case http.StatusForbidden:
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.forbidden", operationTmplPair)
+	case http.StatusInternalServerError:
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.internalServerError")
+	case http.StatusServiceUnavailable:
+		return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaName))
+	default:
+		return err
+	}
+}
+
+func joinPartitions(partitions []int32) string {
+	values := make([]string, len(partitions))
+	for i, p := range partitions {
+		values[i] = strconv.Itoa(int(p))
+	}
+	return strings.Join(values, ", ")
+}
+
+// consumerGroupExists checks whether the consumer group is listed in the Kafka instance
+func consumerGroupExists(opts *importOptions, api *kafkainstanceclient.APIClient) (bool, error) {
+	groups, _, err := groupcmdutil.ListAllConsumerGroups(opts.Context, api, "", opts.id)
+	if err != nil {
+		return false, err
+	}
+
+	for _, group := range groups {
+		if group.GetGroupId() == opts.id {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,24 @@
+package offsets
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewOffsetsCommand creates a new command sub-group for backing up and restoring consumer group offsets
+func NewOffsetsCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offsets",
+		Short:   f.Localizer.MustLocalize("kafka.consumerGroup.offsets.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.consumerGroup.offsets.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.consumerGroup.offsets.cmd.example"),
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		NewExportCommand(f),
+		NewImportCommand(f),
+	)
+
+	return cmd
+}
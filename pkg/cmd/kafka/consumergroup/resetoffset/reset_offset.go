@@ -3,20 +3,26 @@ package resetoffset
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup/groupcmdutil"
 	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/Shopify/sarama"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messagecmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 	"github.com/spf13/cobra"
 )
 
@@ -28,6 +34,10 @@ type options struct {
 	offset      string
 	topic       string
 	partitions  []int32
+	allTopics   bool
+	dryRun      bool
+
+	credentialsFile string
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -67,10 +77,22 @@ func NewResetOffsetConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 				}
 			}
 
-			if opts.value == "" && (opts.offset == groupcmdutil.OffsetAbsolute || opts.offset == groupcmdutil.OffsetTimestamp) {
+			if opts.value == "" && opts.offset != groupcmdutil.OffsetEarliest && opts.offset != groupcmdutil.OffsetLatest {
 				return opts.localizer.MustLocalizeError("kafka.consumerGroup.resetOffset.error.valueRequired", localize.NewEntry("Offset", opts.offset))
 			}
 
+			if opts.topic != "" && opts.allTopics {
+				return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive", localize.NewEntry("Flag1", "topic"), localize.NewEntry("Flag2", "all-topics"))
+			}
+
+			if opts.topic == "" && !opts.allTopics {
+				return opts.localizer.MustLocalizeError("flag.error.requiredOneOf", localize.NewEntry("Flag1", "topic"), localize.NewEntry("Flag2", "all-topics"))
+			}
+
+			if len(opts.partitions) != 0 && opts.allTopics {
+				return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive", localize.NewEntry("Flag1", "partitions"), localize.NewEntry("Flag2", "all-topics"))
+			}
+
 			if opts.kafkaID != "" {
 				return runCmd(opts)
 			}
@@ -99,10 +121,12 @@ func NewResetOffsetConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.offset, "offset", "", opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.flag.offset"))
 	flags.StringVar(&opts.topic, "topic", "", opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.flag.topic"))
 	flags.Int32SliceVar(&opts.partitions, "partitions", []int32{}, opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.flag.partitions"))
+	flags.BoolVar(&opts.allTopics, "all-topics", false, opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.flag.allTopics"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.flag.dryRun"))
+	flags.StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.credentialsFile.description"))
 
 	_ = cmd.MarkFlagRequired("id")
 	_ = cmd.MarkFlagRequired("offset")
-	_ = cmd.MarkFlagRequired("topic")
 
 	// flag based completions for ID
 	_ = cmd.RegisterFlagCompletionFunc("id", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -133,39 +157,33 @@ func runCmd(opts *options) error {
 		return err
 	}
 
-	offsetResetParams := kafkainstanceclient.ConsumerGroupResetOffsetParameters{
-		Offset: opts.offset,
+	if err = validator.ValidateOffsetValue(opts.offset, opts.value); err != nil {
+		return err
 	}
 
-	if opts.value != "" {
-		offsetResetParams.Value = &opts.value
+	_, httpRes, err := api.GroupsApi.GetConsumerGroupById(opts.Context, opts.id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
 	}
 
-	if opts.offset == groupcmdutil.OffsetAbsolute || opts.offset == groupcmdutil.OffsetTimestamp {
-		if err = validator.ValidateOffsetValue(opts.offset, opts.value); err != nil {
+	if err != nil {
+		cgIDPair := localize.NewEntry("ID", opts.id)
+		kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+		if httpRes == nil {
 			return err
 		}
-	}
-
-	if opts.id != "" {
-		_, httpRes, newErr := api.GroupsApi.GetConsumerGroupById(opts.Context, opts.id).Execute()
-		if httpRes != nil {
-			defer httpRes.Body.Close()
+		if httpRes.StatusCode == http.StatusNotFound {
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.notFoundError", cgIDPair, kafkaNameTmplPair)
 		}
+		return err
+	}
 
-		if newErr != nil {
-			cgIDPair := localize.NewEntry("ID", opts.id)
-			kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
-			if httpRes == nil {
-				return newErr
-			}
-			if httpRes.StatusCode == http.StatusNotFound {
-				return opts.localizer.MustLocalizeError("kafka.consumerGroup.common.error.notFoundError", cgIDPair, kafkaNameTmplPair)
-			}
-			return newErr
-		}
+	offsets, err := groupcmdutil.ReadPartitionOffsets(httpRes)
+	if err != nil {
+		return err
 	}
 
+	var topics []string
 	if opts.topic != "" {
 		_, httpRes, newErr := api.TopicsApi.GetTopic(opts.Context, opts.topic).Execute()
 		if httpRes != nil {
@@ -184,39 +202,187 @@ func runCmd(opts *options) error {
 			return newErr
 		}
 
-		topicToReset := kafkainstanceclient.TopicsToResetOffset{
-			Topic: opts.topic,
+		topics = []string{opts.topic}
+	} else {
+		topics = groupcmdutil.TopicsToReset(offsets)
+		if len(topics) == 0 {
+			opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.info.noCommittedOffsets", localize.NewEntry("ID", opts.id)))
+			return nil
+		}
+	}
+
+	now := time.Now()
+
+	// the offsets are previewed before they are reset, unless the confirmation is skipped
+	preview := opts.dryRun || !opts.skipConfirm
+	if preview || opts.offset == groupcmdutil.OffsetShiftBy {
+		// shifted offsets are kept within the partition logs, so the logs are read even without the preview
+		var reader groupcmdutil.OffsetReader
+		if (preview || opts.offset == groupcmdutil.OffsetShiftBy) && opts.offset != groupcmdutil.OffsetLatest && opts.offset != groupcmdutil.OffsetAbsolute {
+			client, clientErr := newKafkaClient(opts, kafkaInstance.GetBootstrapServerHost())
+			if clientErr != nil {
+				opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.debug.offsetsNotAvailable", localize.NewEntry("ErrorMessage", clientErr)))
+			} else {
+				defer client.Close()
+				reader = groupcmdutil.NewOffsetReader(client)
+			}
+		}
+
+		resets, planErr := groupcmdutil.PlanReset(offsets, opts.topic, opts.partitions, opts.offset, opts.value, now, reader)
+		if planErr != nil {
+			if reader == nil {
+				return planErr
+			}
+			// the partition logs are only needed for the preview
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.debug.offsetsNotAvailable", localize.NewEntry("ErrorMessage", planErr)))
+			if resets, planErr = groupcmdutil.PlanReset(offsets, opts.topic, opts.partitions, opts.offset, opts.value, now, nil); planErr != nil {
+				return planErr
+			}
+		}
+
+		if preview {
+			opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.info.preview", localize.NewEntry("ID", opts.id)))
+			opts.Logger.Info()
+			dump.Table(opts.IO.Out, groupcmdutil.MapResetsToTableRows(resets))
+			opts.Logger.Info()
 		}
 
-		if len(opts.partitions) != 0 {
-			topicToReset.Partitions = &opts.partitions
+		for _, reset := range resets {
+			if reset.Clamped {
+				opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.info.offsetClamped",
+					localize.NewEntry("Topic", reset.Topic),
+					localize.NewEntry("Partition", reset.Partition),
+					localize.NewEntry("Offset", *reset.TargetOffset),
+				))
+			}
 		}
 
-		topicsToResetArr := []kafkainstanceclient.TopicsToResetOffset{topicToReset}
+		if opts.dryRun {
+			opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.info.dryRun"))
+			return nil
+		}
 
-		offsetResetParams.Topics = &topicsToResetArr
+		if opts.offset == groupcmdutil.OffsetShiftBy {
+			if confirmed, confirmErr := confirmReset(opts); !confirmed || confirmErr != nil {
+				return confirmErr
+			}
+
+			return resetShiftedOffsets(opts, api, kafkaInstance, resets)
+		}
 	}
 
-	a := api.GroupsApi.ResetConsumerGroupOffset(opts.Context, opts.id).ConsumerGroupResetOffsetParameters(offsetResetParams)
+	if confirmed, confirmErr := confirmReset(opts); !confirmed || confirmErr != nil {
+		return confirmErr
+	}
 
-	if !opts.skipConfirm {
+	offsetResetParams := kafkainstanceclient.ConsumerGroupResetOffsetParameters{
+		Offset: opts.offset,
+	}
 
-		var confirmReset bool
-		opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.input.confirmReset.message", localize.NewEntry("ID", opts.id))
-		promptConfirmReset := &survey.Confirm{
-			Message: opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.input.confirmReset.message", localize.NewEntry("ID", opts.id)),
+	if opts.value != "" {
+		offsetResetParams.Value = &opts.value
+	}
+
+	// a duration is reset as the timestamp relative to the current time
+	if opts.offset == groupcmdutil.OffsetDuration {
+		t, _ := groupcmdutil.ResetTimestamp(opts.offset, opts.value, now)
+		timestamp := t.Format(groupcmdutil.TimestampOffsetLayout)
+		offsetResetParams.Offset = groupcmdutil.OffsetTimestamp
+		offsetResetParams.Value = &timestamp
+	}
+
+	topicsToResetArr := make([]kafkainstanceclient.TopicsToResetOffset, len(topics))
+	for i, topic := range topics {
+		topicsToResetArr[i] = kafkainstanceclient.TopicsToResetOffset{
+			Topic: topic,
 		}
+	}
 
-		if err = survey.AskOne(promptConfirmReset, &confirmReset); err != nil {
-			return err
+	if len(opts.partitions) != 0 {
+		topicsToResetArr[0].Partitions = &opts.partitions
+	}
+
+	offsetResetParams.Topics = &topicsToResetArr
+
+	if err = resetOffsets(opts, api, kafkaInstance, offsetResetParams); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize(
+		"kafka.consumerGroup.resetOffset.log.info.successful",
+		localize.NewEntry("ConsumerGroupID", opts.id),
+		localize.NewEntry("InstanceName", kafkaInstance.GetName())),
+	)
+
+	return nil
+}
+
+// confirmReset asks the user to confirm the reset, unless the confirmation is skipped
+func confirmReset(opts *options) (bool, error) {
+	if opts.skipConfirm {
+		return true, nil
+	}
+
+	var confirmReset bool
+	promptConfirmReset := &survey.Confirm{
+		Message: opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.input.confirmReset.message", localize.NewEntry("ID", opts.id)),
+	}
+
+	if err := survey.AskOne(promptConfirmReset, &confirmReset); err != nil {
+		return false, err
+	}
+	if !confirmReset {
+		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.debug.cancelledReset"))
+	}
+
+	return confirmReset, nil
+}
+
+// resetShiftedOffsets resets each partition to its shifted offset, as the offsets differ between partitions.
+// The partitions are reset one at a time, so it stops on the first failure
+// and reports the partitions which were already reset
+func resetShiftedOffsets(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest, resets []groupcmdutil.PartitionReset) error {
+	for i, reset := range resets {
+		value := strconv.FormatInt(*reset.TargetOffset, 10)
+		partitions := []int32{reset.Partition}
+		topicsToResetArr := []kafkainstanceclient.TopicsToResetOffset{
+			{Topic: reset.Topic, Partitions: &partitions},
 		}
-		if !confirmReset {
-			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.debug.cancelledReset"))
-			return nil
+
+		offsetResetParams := kafkainstanceclient.ConsumerGroupResetOffsetParameters{
+			Offset: groupcmdutil.OffsetAbsolute,
+			Value:  &value,
+			Topics: &topicsToResetArr,
 		}
+
+		if err := resetOffsets(opts, api, kafkaInstance, offsetResetParams); err != nil {
+			return opts.localizer.MustLocalizeError("kafka.consumerGroup.resetOffset.error.partialReset",
+				localize.NewEntry("Topic", reset.Topic),
+				localize.NewEntry("Partition", reset.Partition),
+				localize.NewEntry("Applied", i),
+				localize.NewEntry("Total", len(resets)),
+				localize.NewEntry("ErrorMessage", err),
+			)
+		}
+
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.resetOffset.log.info.partitionReset",
+			localize.NewEntry("Topic", reset.Topic),
+			localize.NewEntry("Partition", reset.Partition),
+			localize.NewEntry("Offset", value),
+		))
 	}
 
-	_, httpRes, err := a.Execute()
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize(
+		"kafka.consumerGroup.resetOffset.log.info.successful",
+		localize.NewEntry("ConsumerGroupID", opts.id),
+		localize.NewEntry("InstanceName", kafkaInstance.GetName())),
+	)
+
+	return nil
+}
+
+func resetOffsets(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest, offsetResetParams kafkainstanceclient.ConsumerGroupResetOffsetParameters) error {
+	_, httpRes, err := api.GroupsApi.ResetConsumerGroupOffset(opts.Context, opts.id).ConsumerGroupResetOffsetParameters(offsetResetParams).Execute()
 	if httpRes != nil {
 		defer httpRes.Body.Close()
 	}
@@ -243,13 +409,22 @@ func runCmd(opts *options) error {
 		}
 	}
 
-	defer httpRes.Body.Close()
+	return nil
+}
 
-	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize(
-		"kafka.consumerGroup.resetOffset.log.info.successful",
-		localize.NewEntry("ConsumerGroupID", opts.id),
-		localize.NewEntry("InstanceName", kafkaInstance.GetName())),
-	)
+// newKafkaClient connects to the Kafka instance to read the partition logs for the preview
+func newKafkaClient(opts *options, bootstrapServer string) (sarama.Client, error) {
+	// the configuration is loaded after the connection is created,
+	// so that it contains the refreshed access token
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return nil, err
+	}
 
-	return nil
+	tokenSource, err := messagecmdutil.NewTokenSource(opts.Context, cfg, opts.credentialsFile)
+	if err != nil {
+		return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+	}
+
+	return sarama.NewClient([]string{bootstrapServer}, messagecmdutil.NewClientConfig(tokenSource))
 }
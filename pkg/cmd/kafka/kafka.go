@@ -5,6 +5,7 @@ package kafka
 import (
 	"github.com/redhat-developer/app-services-cli/internal/doc"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/config"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/delete"
@@ -38,6 +39,7 @@ func NewKafkaCommand(f *factory.Factory) *cobra.Command {
 		consumergroup.NewConsumerGroupCommand(f),
 		update.NewUpdateCommand(f),
 		acl.NewAclCommand(f),
+		config.NewConfigCommand(f),
 	)
 
 	return cmd
@@ -0,0 +1,285 @@
+package consume
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messagecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+	"github.com/spf13/cobra"
+)
+
+var validOutputFormats = []string{messagecmdutil.JSONFormat}
+
+type options struct {
+	topicName       string
+	kafkaID         string
+	fromBeginning   bool
+	offset          int64
+	timestamp       string
+	group           string
+	maxMessages     int
+	outputFormat    string
+	credentialsFile string
+	registryID      string
+
+	startTime *time.Time
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewConsumeTopicCommand gets a new command for consuming messages from a kafka topic.
+func NewConsumeTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "consume",
+		Short:   opts.localizer.MustLocalize("kafka.topic.consume.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.consume.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.consume.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			offsetSet := cmd.Flags().Changed("offset")
+
+			var startFlags int
+			for _, set := range []bool{opts.fromBeginning, offsetSet, opts.timestamp != ""} {
+				if set {
+					startFlags++
+				}
+			}
+			if startFlags > 1 {
+				return opts.localizer.MustLocalizeError("kafka.topic.consume.error.startFlagsExclusive")
+			}
+
+			if opts.group != "" && (offsetSet || opts.timestamp != "") {
+				return opts.localizer.MustLocalizeError("kafka.topic.consume.error.groupWithStartPosition")
+			}
+
+			if offsetSet && opts.offset < 0 {
+				return opts.localizer.MustLocalizeError("kafka.topic.consume.error.invalidOffset", localize.NewEntry("Offset", opts.offset))
+			}
+
+			if opts.maxMessages < 0 {
+				return opts.localizer.MustLocalizeError("kafka.topic.consume.error.invalidMaxMessages", localize.NewEntry("MaxMessages", opts.maxMessages))
+			}
+
+			if opts.timestamp != "" {
+				startTime, parseErr := time.Parse(time.RFC3339, opts.timestamp)
+				if parseErr != nil {
+					return opts.localizer.MustLocalizeError("kafka.topic.consume.error.invalidTimestamp", localize.NewEntry("Timestamp", opts.timestamp))
+				}
+				opts.startTime = &startTime
+			}
+
+			if !offsetSet {
+				opts.offset = sarama.OffsetNewest
+				if opts.fromBeginning {
+					opts.offset = sarama.OffsetOldest
+				}
+			}
+
+			if opts.kafkaID != "" {
+				return runCmd(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.topic.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runCmd(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.StringVar(&opts.topicName, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.name.description"))
+	flags.BoolVar(&opts.fromBeginning, "from-beginning", false, opts.localizer.MustLocalize("kafka.topic.consume.flag.fromBeginning.description"))
+	flags.Int64Var(&opts.offset, "offset", 0, opts.localizer.MustLocalize("kafka.topic.consume.flag.offset.description"))
+	flags.StringVar(&opts.timestamp, "timestamp", "", opts.localizer.MustLocalize("kafka.topic.consume.flag.timestamp.description"))
+	flags.StringVar(&opts.group, "group", "", opts.localizer.MustLocalize("kafka.topic.consume.flag.group.description"))
+	flags.IntVar(&opts.maxMessages, "max-messages", 0, opts.localizer.MustLocalize("kafka.topic.consume.flag.maxMessages.description"))
+	flags.StringVarP(&opts.outputFormat, "output", "o", "", flagutil.FlagDescription(opts.localizer, "kafka.topic.consume.flag.output.description", validOutputFormats...))
+	flags.StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.credentialsFile.description"))
+	flags.StringVar(&opts.registryID, "registry-id", "", opts.localizer.MustLocalize("kafka.topic.common.flag.registryID.description"))
+
+	_ = cmd.MarkFlagRequired("name")
+
+	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	_ = cmd.RegisterFlagCompletionFunc("group", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidConsumerGroupIDs(f, toComplete)
+	})
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output", validOutputFormats)
+
+	return cmd
+}
+
+// nolint:funlen
+func runCmd(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	topicNameTmplPair := localize.NewEntry("TopicName", opts.topicName)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	_, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.topicName).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "consume")
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.topicNotFoundError", topicNameTmplPair, kafkaNameTmplPair)
+		case http.StatusUnauthorized:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return err
+		}
+	}
+
+	// the configuration is loaded after the connection is created,
+	// so that it contains the refreshed access token
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	tokenSource, err := messagecmdutil.NewTokenSource(opts.Context, cfg, opts.credentialsFile)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+	}
+
+	bootstrapServer := kafkaInstance.GetBootstrapServerHost()
+	clientConfig := messagecmdutil.NewClientConfig(tokenSource)
+
+	consumeOpts := &messagecmdutil.ConsumeOptions{
+		Topic:       opts.topicName,
+		Offset:      opts.offset,
+		Timestamp:   opts.startTime,
+		MaxMessages: opts.maxMessages,
+	}
+
+	consumeOpts.Deserializer, err = newDeserializer(opts, conn, cfg)
+	if err != nil {
+		return err
+	}
+
+	// values of topics which do not use the current Service Registry instance,
+	// such as binary values starting with a zero byte, are printed as they are
+	if opts.registryID == "" {
+		consumeOpts.DecodeErrorHandler = func(_ *sarama.ConsumerMessage, err error) error {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.consume.log.debug.decodeFailed", localize.NewEntry("ErrorMessage", err)))
+			return nil
+		}
+	}
+
+	printer := messagecmdutil.NewRecordPrinter(opts.IO.Out, opts.outputFormat)
+
+	// consume until interrupted, or the maximum number of messages is reached
+	ctx, stop := signal.NotifyContext(opts.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if opts.group != "" {
+		clientConfig.Consumer.Offsets.Initial = opts.offset
+
+		group, err := sarama.NewConsumerGroup([]string{bootstrapServer}, opts.group, clientConfig)
+		if err != nil {
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotConnect", localize.NewEntry("Name", kafkaInstance.GetName()), localize.NewEntry("ErrorMessage", err))
+		}
+		defer group.Close()
+
+		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.consume.log.debug.joiningGroup", localize.NewEntry("Group", opts.group)))
+
+		return messagecmdutil.ConsumeGroup(ctx, group, consumeOpts, printer)
+	}
+
+	client, err := sarama.NewClient([]string{bootstrapServer}, clientConfig)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotConnect", localize.NewEntry("Name", kafkaInstance.GetName()), localize.NewEntry("ErrorMessage", err))
+	}
+	defer client.Close()
+
+	return messagecmdutil.ConsumePartitions(ctx, client, consumeOpts, printer)
+}
+
+// newDeserializer creates a deserializer which fetches schemas from Service Registry.
+// Without the --registry-id flag the current Service Registry instance is used, if any,
+// and messages are not decoded when it is not available
+func newDeserializer(opts *options, conn connection.Connection, cfg *config.Config) (*messagecmdutil.Deserializer, error) {
+	registryID := opts.registryID
+	if registryID == "" {
+		instanceID, ok := cfg.GetServiceRegistryIdOk()
+		if !ok {
+			return nil, nil
+		}
+		registryID = instanceID
+	}
+
+	registryAPI, _, err := conn.API().ServiceRegistryInstance(registryID)
+	if err != nil {
+		if opts.registryID != "" {
+			return nil, err
+		}
+		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.consume.log.debug.registryNotAvailable", localize.NewEntry("ErrorMessage", err)))
+		return nil, nil
+	}
+
+	return messagecmdutil.NewDeserializer(messagecmdutil.NewRegistrySchemaResolver(opts.Context, registryAPI)), nil
+}
@@ -0,0 +1,330 @@
+package copy
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/bulkutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	name            string
+	newName         string
+	all             bool
+	fromInstance    string
+	toInstance      string
+	onConflict      string
+	dryRun          bool
+	continueOnError bool
+	skipConfirm     bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewCopyTopicCommand gets a new command for copying kafka topics to another Kafka instance.
+// nolint:funlen
+func NewCopyTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "copy",
+		Short:   opts.localizer.MustLocalize("kafka.topic.copy.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.copy.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.copy.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if opts.name != "" && opts.all {
+				return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive", localize.NewEntry("Flag1", "name"), localize.NewEntry("Flag2", "all"))
+			}
+
+			if opts.name == "" && !opts.all {
+				return opts.localizer.MustLocalizeError("flag.error.requiredOneOf", localize.NewEntry("Flag1", "name"), localize.NewEntry("Flag2", "all"))
+			}
+
+			if opts.newName != "" && opts.all {
+				return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive", localize.NewEntry("Flag1", "new-name"), localize.NewEntry("Flag2", "all"))
+			}
+
+			if !opts.IO.CanPrompt() && !opts.skipConfirm && !opts.dryRun {
+				return flagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if !flagutil.IsValidInput(opts.onConflict, topiccmdutil.ValidConflictStrategies...) {
+				return flagutil.InvalidValueError("on-conflict", opts.onConflict, topiccmdutil.ValidConflictStrategies...)
+			}
+
+			validator := topiccmdutil.Validator{
+				Localizer: opts.localizer,
+			}
+
+			if opts.newName != "" {
+				if err = validator.ValidateName(opts.newName); err != nil {
+					return err
+				}
+			}
+
+			if opts.fromInstance == "" {
+				cfg, err := opts.Config.Load()
+				if err != nil {
+					return err
+				}
+
+				instanceID, ok := cfg.GetKafkaIdOk()
+				if !ok {
+					return opts.localizer.MustLocalizeError("kafka.topic.common.error.noKafkaSelected")
+				}
+
+				opts.fromInstance = instanceID
+			}
+
+			// a topic can only be copied to the same instance with a new name
+			if opts.fromInstance == opts.toInstance && (opts.all || opts.newName == "" || opts.newName == opts.name) {
+				return opts.localizer.MustLocalizeError("kafka.topic.copy.error.sameInstance")
+			}
+
+			return runCmd(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.topic.copy.flag.name.description"))
+	flags.StringVar(&opts.newName, "new-name", "", opts.localizer.MustLocalize("kafka.topic.copy.flag.newName.description"))
+	flags.BoolVar(&opts.all, "all", false, opts.localizer.MustLocalize("kafka.topic.copy.flag.all.description"))
+	flags.StringVar(&opts.fromInstance, "from-instance", "", opts.localizer.MustLocalize("kafka.topic.copy.flag.fromInstance.description"))
+	flags.StringVar(&opts.toInstance, "to-instance", "", opts.localizer.MustLocalize("kafka.topic.copy.flag.toInstance.description"))
+	flags.StringVar(&opts.onConflict, "on-conflict", topiccmdutil.ConflictFail, flagutil.FlagDescription(opts.localizer, "kafka.topic.copy.flag.onConflict.description", topiccmdutil.ValidConflictStrategies...))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.topic.copy.flag.dryRun.description"))
+	flags.BoolVar(&opts.continueOnError, "continue-on-error", false, opts.localizer.MustLocalize("kafka.topic.common.flag.continueOnError.description"))
+	flags.AddYes(&opts.skipConfirm)
+
+	_ = cmd.MarkFlagRequired("to-instance")
+
+	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	flagutil.EnableStaticFlagCompletion(cmd, "on-conflict", topiccmdutil.ValidConflictStrategies)
+
+	return cmd
+}
+
+// nolint:funlen
+func runCmd(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	sourceAPI, sourceInstance, err := conn.API().KafkaAdmin(opts.fromInstance)
+	if err != nil {
+		return err
+	}
+
+	targetAPI, targetInstance, err := conn.API().KafkaAdmin(opts.toInstance)
+	if err != nil {
+		return err
+	}
+
+	sourceTopics, err := getSourceTopics(opts, sourceAPI, sourceInstance)
+	if err != nil {
+		return err
+	}
+
+	if len(sourceTopics) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.list.log.info.noTopics", localize.NewEntry("InstanceName", sourceInstance.GetName())))
+		return nil
+	}
+
+	targetTopics, _, err := topiccmdutil.ListAllTopics(opts.Context, targetAPI)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.listTopics", localize.NewEntry("InstanceName", targetInstance.GetName()), localize.NewEntry("ErrorMessage", err))
+	}
+
+	existing := make(map[string]*kafkainstanceclient.Topic, len(targetTopics))
+	for i := range targetTopics {
+		existing[targetTopics[i].GetName()] = &targetTopics[i]
+	}
+
+	plans := make(map[string]*topiccmdutil.CopyPlan, len(sourceTopics))
+	orderedPlans := make([]*topiccmdutil.CopyPlan, 0, len(sourceTopics))
+	var names, conflicts []string
+	for i := range sourceTopics {
+		targetName := sourceTopics[i].GetName()
+		if opts.newName != "" {
+			targetName = opts.newName
+		}
+
+		plan := topiccmdutil.PlanCopy(&sourceTopics[i], targetName, existing[targetName], opts.onConflict)
+		plans[targetName] = plan
+		orderedPlans = append(orderedPlans, plan)
+
+		switch plan.Action {
+		case topiccmdutil.CopyCreate, topiccmdutil.CopyUpdate:
+			names = append(names, targetName)
+		case topiccmdutil.CopyConflict:
+			conflicts = append(conflicts, targetName)
+		}
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.topic.copy.log.info.plan", len(orderedPlans),
+		localize.NewEntry("Count", len(orderedPlans)),
+		localize.NewEntry("From", sourceInstance.GetName()),
+		localize.NewEntry("To", targetInstance.GetName()),
+	))
+	opts.Logger.Info()
+	dump.Table(opts.IO.Out, topiccmdutil.MapCopyPlansToTableRows(orderedPlans))
+	opts.Logger.Info()
+
+	if opts.dryRun {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.dryRun"))
+		return nil
+	}
+
+	if len(conflicts) > 0 {
+		return opts.localizer.MustLocalizeError("kafka.topic.copy.error.conflict",
+			localize.NewEntry("Topics", strings.Join(conflicts, ", ")),
+			localize.NewEntry("InstanceName", targetInstance.GetName()),
+		)
+	}
+
+	if len(names) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.nothingToCopy"))
+		return nil
+	}
+
+	if !opts.skipConfirm {
+		prompt := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("kafka.topic.copy.input.confirmCopyMessage", localize.NewEntry("InstanceName", targetInstance.GetName())),
+		}
+		if err = survey.AskOne(prompt, &opts.skipConfirm); err != nil {
+			return err
+		}
+
+		if !opts.skipConfirm {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.copy.log.debug.copyNotConfirmed"))
+			return nil
+		}
+	}
+
+	results := bulkutil.Run(names, opts.continueOnError, func(name string) error {
+		return copyTopic(opts, targetAPI, targetInstance, plans[name])
+	})
+
+	return topiccmdutil.PrintBulkResults(opts.IO.Out, results, opts.localizer, opts.localizer.MustLocalize("kafka.topic.copy.bulk.status.copied"))
+}
+
+// getSourceTopics fetches the topic set by the --name flag, or all topics of the source instance
+func getSourceTopics(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) ([]kafkainstanceclient.Topic, error) {
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	if opts.all {
+		topics, _, err := topiccmdutil.ListAllTopics(opts.Context, api)
+		if err != nil {
+			return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.listTopics", kafkaNameTmplPair, localize.NewEntry("ErrorMessage", err))
+		}
+		return topics, nil
+	}
+
+	topic, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.name).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		if httpRes == nil {
+			return nil, err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "describe")
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.topicNotFoundError", localize.NewEntry("TopicName", opts.name), kafkaNameTmplPair)
+		case http.StatusUnauthorized:
+			return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return nil, err
+		}
+	}
+
+	return []kafkainstanceclient.Topic{topic}, nil
+}
+
+// copyTopic creates or updates the topic in the target instance
+func copyTopic(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest, plan *topiccmdutil.CopyPlan) error {
+	var (
+		httpRes   *http.Response
+		err       error
+		operation string
+	)
+
+	if plan.Action == topiccmdutil.CopyCreate {
+		operation = "create"
+		_, httpRes, err = api.TopicsApi.CreateTopic(opts.Context).NewTopicInput(plan.NewTopicInput()).Execute()
+	} else {
+		operation = "update"
+		_, httpRes, err = api.TopicsApi.UpdateTopic(opts.Context, plan.Target).UpdateTopicInput(plan.UpdateTopicInput()).Execute()
+	}
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err == nil {
+		return nil
+	}
+
+	if httpRes == nil {
+		return err
+	}
+
+	topicNameTmplPair := localize.NewEntry("TopicName", plan.Target)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+	operationTmplPair := localize.NewEntry("Operation", operation)
+	switch httpRes.StatusCode {
+	case http.StatusNotFound:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
+	case http.StatusConflict:
+		return opts.localizer.MustLocalizeError("kafka.topic.create.error.conflictError", topicNameTmplPair, kafkaNameTmplPair)
+	case http.StatusUnauthorized:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+	case http.StatusForbidden:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+	case http.StatusInternalServerError:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+	case http.StatusServiceUnavailable:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+	default:
+		return err
+	}
+}
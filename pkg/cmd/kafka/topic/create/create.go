@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/bulkutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
@@ -15,6 +16,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 
 	"github.com/AlecAivazis/survey/v2"
 
@@ -36,6 +38,15 @@ type options struct {
 	outputFormat   string
 	cleanupPolicy  string
 	interactive    bool
+	config         []string
+	configFile     string
+	fromFile       string
+	force          bool
+
+	continueOnError bool
+
+	// configs contains the topic configurations set with the --config and --config-file flags
+	configs map[string]string
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -63,7 +74,15 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 		Example: opts.localizer.MustLocalize("kafka.topic.create.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			if !opts.IO.CanPrompt() && opts.topicName == "" {
+			if opts.fromFile != "" {
+				if opts.topicName != "" {
+					return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive", localize.NewEntry("Flag1", "name"), localize.NewEntry("Flag2", "from-file"))
+				}
+
+				if !opts.IO.CanPrompt() && !opts.force {
+					return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "yes"))
+				}
+			} else if !opts.IO.CanPrompt() && opts.topicName == "" {
 				return opts.localizer.MustLocalizeError("argument.error.requiredWhenNonInteractive", localize.NewEntry("Argument", "name"))
 			} else if opts.topicName == "" {
 				opts.interactive = true
@@ -86,8 +105,10 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 					Localizer: opts.localizer,
 				}
 
-				if err = validator.ValidateName(opts.topicName); err != nil {
-					return err
+				if opts.fromFile == "" {
+					if err = validator.ValidateName(opts.topicName); err != nil {
+						return err
+					}
 				}
 
 				if err = validator.ValidatePartitionsN(opts.partitions); err != nil {
@@ -103,6 +124,30 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 				}
 			}
 
+			opts.configs, err = topiccmdutil.LoadConfigs(opts.config, opts.configFile)
+			if err != nil {
+				return err
+			}
+
+			// explicitly set flags take precedence over the generic topic configurations
+			if cmd.Flags().Changed("retention-ms") {
+				opts.configs[topiccmdutil.RetentionMsKey] = strconv.Itoa(opts.retentionMs)
+			}
+			if cmd.Flags().Changed("retention-bytes") {
+				opts.configs[topiccmdutil.RetentionSizeKey] = strconv.Itoa(opts.retentionBytes)
+			}
+			if cmd.Flags().Changed("cleanup-policy") {
+				opts.configs[topiccmdutil.CleanupPolicy] = opts.cleanupPolicy
+			}
+
+			validator := topiccmdutil.Validator{
+				Localizer: opts.localizer,
+			}
+
+			if err = validator.ValidateConfigs(opts.configs); err != nil {
+				return err
+			}
+
 			if opts.kafkaID != "" {
 				return runCmd(opts)
 			}
@@ -130,7 +175,12 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 	flags.IntVar(&opts.retentionMs, "retention-ms", defaultRetentionPeriodMS, opts.localizer.MustLocalize("kafka.topic.common.input.retentionMs.description"))
 	flags.IntVar(&opts.retentionBytes, "retention-bytes", defaultRetentionSize, opts.localizer.MustLocalize("kafka.topic.common.input.retentionBytes.description"))
 	flags.StringVar(&opts.cleanupPolicy, "cleanup-policy", defaultCleanupPolicy, opts.localizer.MustLocalize("kafka.topic.common.input.cleanupPolicy.description"))
+	flags.StringArrayVar(&opts.config, "config", []string{}, opts.localizer.MustLocalize("kafka.topic.common.flag.config.description"))
+	flags.StringVar(&opts.configFile, "config-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.configFile.description"))
+	flags.StringVar(&opts.fromFile, "from-file", "", opts.localizer.MustLocalize("kafka.topic.create.flag.fromFile.description"))
+	flags.BoolVar(&opts.continueOnError, "continue-on-error", false, opts.localizer.MustLocalize("kafka.topic.common.flag.continueOnError.description"))
 	flags.AddOutput(&opts.outputFormat)
+	flags.AddYes(&opts.force)
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 	flagutil.EnableStaticFlagCompletion(cmd, "cleanup-policy", topiccmdutil.ValidCleanupPolicies)
@@ -146,6 +196,11 @@ func runCmd(opts *options) error {
 		if err != nil {
 			return err
 		}
+
+		// the values entered in the prompts take precedence over the flags
+		delete(opts.configs, topiccmdutil.RetentionMsKey)
+		delete(opts.configs, topiccmdutil.RetentionSizeKey)
+		delete(opts.configs, topiccmdutil.CleanupPolicy)
 	}
 
 	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
@@ -158,42 +213,21 @@ func runCmd(opts *options) error {
 		return err
 	}
 
-	createTopicReq := api.TopicsApi.CreateTopic(opts.Context)
+	if opts.fromFile != "" {
+		return runBulkCreate(opts, api, kafkaInstance)
+	}
 
 	topicInput := kafkainstanceclient.NewTopicInput{
 		Name: opts.topicName,
 		Settings: kafkainstanceclient.TopicSettings{
 			NumPartitions: opts.partitions,
-			Config:        createConfigEntries(opts),
+			Config:        createConfigEntries(opts, nil),
 		},
 	}
-	createTopicReq = createTopicReq.NewTopicInput(topicInput)
-
-	response, httpRes, err := createTopicReq.Execute()
-	if httpRes != nil {
-		defer httpRes.Body.Close()
-	}
 
+	response, err := createTopic(opts, api, kafkaInstance, topicInput)
 	if err != nil {
-		if httpRes == nil {
-			return err
-		}
-
-		operationTmplPair := localize.NewEntry("Operation", "create")
-		switch httpRes.StatusCode {
-		case http.StatusUnauthorized:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
-		case http.StatusForbidden:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
-		case http.StatusConflict:
-			return opts.localizer.MustLocalizeError("kafka.topic.create.error.conflictError", localize.NewEntry("TopicName", opts.topicName), localize.NewEntry("InstanceName", kafkaInstance.GetName()))
-		case http.StatusInternalServerError:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
-		case http.StatusServiceUnavailable:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
-		default:
-			return err
-		}
+		return err
 	}
 
 	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.create.log.info.topicCreated", localize.NewEntry("TopicName", response.GetName()), localize.NewEntry("InstanceName", kafkaInstance.GetName())))
@@ -275,7 +309,114 @@ func runInteractivePrompt(opts *options) (err error) {
 	return nil
 }
 
-func createConfigEntries(opts *options) *[]kafkainstanceclient.ConfigEntry {
+// runBulkCreate creates all topics defined in the topics file
+func runBulkCreate(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	specs, err := topiccmdutil.ReadTopicsFile(opts.fromFile)
+	if err != nil {
+		return err
+	}
+
+	validator := topiccmdutil.Validator{
+		Localizer: opts.localizer,
+	}
+
+	// the topics are validated before any of them is created
+	inputs := make(map[string]kafkainstanceclient.NewTopicInput, len(specs))
+	names := make([]string, 0, len(specs))
+	for i := range specs {
+		spec := specs[i]
+		topicNameTmplPair := localize.NewEntry("TopicName", spec.Name)
+
+		partitions := opts.partitions
+		if spec.Partitions != 0 {
+			partitions = spec.Partitions
+		}
+
+		configs, err := spec.Configs()
+		if err == nil {
+			err = validator.ValidateName(spec.Name)
+		}
+		if err == nil {
+			err = validator.ValidatePartitionsN(partitions)
+		}
+		if err == nil {
+			err = validator.ValidateConfigs(configs)
+		}
+		if err != nil {
+			return opts.localizer.MustLocalizeError("kafka.topic.create.error.invalidTopicInFile", topicNameTmplPair, localize.NewEntry("ErrorMessage", err))
+		}
+
+		inputs[spec.Name] = kafkainstanceclient.NewTopicInput{
+			Name: spec.Name,
+			Settings: kafkainstanceclient.TopicSettings{
+				NumPartitions: partitions,
+				Config:        createConfigEntries(opts, configs),
+			},
+		}
+		names = append(names, spec.Name)
+	}
+
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.topic.create.log.info.topicsPreview", len(names), kafkaNameTmplPair, localize.NewEntry("Count", len(names))))
+	opts.Logger.Info()
+	for _, name := range names {
+		opts.Logger.Info("  " + name)
+	}
+	opts.Logger.Info()
+
+	if !opts.force {
+		confirmed, err := bulkutil.Confirm(opts.localizer)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.common.bulk.log.debug.notConfirmed"))
+			return nil
+		}
+	}
+
+	results := bulkutil.Run(names, opts.continueOnError, func(name string) error {
+		_, err := createTopic(opts, api, kafkaInstance, inputs[name])
+		return err
+	})
+
+	return topiccmdutil.PrintBulkResults(opts.IO.Out, results, opts.localizer, opts.localizer.MustLocalize("kafka.topic.create.bulk.status.created"))
+}
+
+// createTopic performs the create topic API request
+func createTopic(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest, topicInput kafkainstanceclient.NewTopicInput) (kafkainstanceclient.Topic, error) {
+	response, httpRes, err := api.TopicsApi.CreateTopic(opts.Context).NewTopicInput(topicInput).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err == nil {
+		return response, nil
+	}
+
+	if httpRes == nil {
+		return response, err
+	}
+
+	operationTmplPair := localize.NewEntry("Operation", "create")
+	switch httpRes.StatusCode {
+	case http.StatusUnauthorized:
+		return response, opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+	case http.StatusForbidden:
+		return response, opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+	case http.StatusConflict:
+		return response, opts.localizer.MustLocalizeError("kafka.topic.create.error.conflictError", localize.NewEntry("TopicName", topicInput.Name), localize.NewEntry("InstanceName", kafkaInstance.GetName()))
+	case http.StatusInternalServerError:
+		return response, opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+	case http.StatusServiceUnavailable:
+		return response, opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+	default:
+		return response, err
+	}
+}
+
+// createConfigEntries creates the config entries of a new topic from the flags,
+// overridden by the configurations of the topic in the topics file, if any
+func createConfigEntries(opts *options, topicConfigs map[string]string) *[]kafkainstanceclient.ConfigEntry {
 	retentionMsStr := strconv.Itoa(opts.retentionMs)
 	retentionBytesStr := strconv.Itoa(opts.retentionBytes)
 	cleanupPolicyStr := opts.cleanupPolicy
@@ -284,5 +425,13 @@ func createConfigEntries(opts *options) *[]kafkainstanceclient.ConfigEntry {
 		topiccmdutil.RetentionSizeKey: &retentionBytesStr,
 		topiccmdutil.CleanupPolicy:    &cleanupPolicyStr,
 	}
+	for key := range opts.configs {
+		value := opts.configs[key]
+		configEntryMap[key] = &value
+	}
+	for key := range topicConfigs {
+		value := topicConfigs[key]
+		configEntryMap[key] = &value
+	}
 	return topiccmdutil.CreateConfigEntries(configEntryMap)
 }
@@ -5,22 +5,28 @@ import (
 	"net/http"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
 	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/bulkutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 	"github.com/spf13/cobra"
 )
 
 type options struct {
-	topicName string
-	kafkaID   string
-	force     bool
+	topicName       string
+	pattern         string
+	kafkaID         string
+	force           bool
+	continueOnError bool
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -48,6 +54,14 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 		Example: opts.localizer.MustLocalize("kafka.topic.delete.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if opts.topicName != "" && opts.pattern != "" {
+				return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive", localize.NewEntry("Flag1", "name"), localize.NewEntry("Flag2", "pattern"))
+			}
+
+			if opts.topicName == "" && opts.pattern == "" {
+				return opts.localizer.MustLocalizeError("flag.error.requiredOneOf", localize.NewEntry("Flag1", "name"), localize.NewEntry("Flag2", "pattern"))
+			}
+
 			if !opts.IO.CanPrompt() && !opts.force {
 				return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "yes"))
 			}
@@ -75,8 +89,8 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 	flags := flagutil.NewFlagSet(cmd, opts.localizer)
 
 	flags.StringVar(&opts.topicName, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.name.description"))
-
-	_ = cmd.MarkFlagRequired("name")
+	flags.StringVar(&opts.pattern, "pattern", "", opts.localizer.MustLocalize("kafka.topic.common.flag.pattern.description"))
+	flags.BoolVar(&opts.continueOnError, "continue-on-error", false, opts.localizer.MustLocalize("kafka.topic.common.flag.continueOnError.description"))
 
 	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
@@ -98,6 +112,10 @@ func runCmd(opts *options) error {
 		return err
 	}
 
+	if opts.pattern != "" {
+		return runBulkDelete(opts, api, kafkaInstance)
+	}
+
 	// perform delete topic API request
 	_, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.topicName).Execute()
 	if httpRes != nil {
@@ -129,34 +147,93 @@ func runCmd(opts *options) error {
 		}
 	}
 
-	// perform delete topic API request
-	httpRes, err = api.TopicsApi.DeleteTopic(opts.Context, opts.topicName).Execute()
-	if httpRes != nil {
-		defer httpRes.Body.Close()
+	if err = deleteTopic(opts, api, kafkaInstance, opts.topicName); err != nil {
+		return err
 	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicDeleted", topicNameTmplPair, kafkaNameTmplPair))
+
+	return nil
+}
+
+// runBulkDelete deletes all topics with names matching the pattern
+func runBulkDelete(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	topics, _, err := topiccmdutil.ListAllTopics(opts.Context, api)
 	if err != nil {
-		if httpRes == nil {
-			return err
-		}
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.listTopics", kafkaNameTmplPair, localize.NewEntry("ErrorMessage", err))
+	}
 
-		operationTmplPair := localize.NewEntry("Operation", "delete")
-		switch httpRes.StatusCode {
-		case http.StatusNotFound:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
-		case http.StatusUnauthorized:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
-		case http.StatusForbidden:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
-		case http.StatusInternalServerError:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
-		case http.StatusServiceUnavailable:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
-		default:
+	matched, err := topiccmdutil.MatchTopics(topics, opts.pattern)
+	if err != nil {
+		return err
+	}
+
+	if len(matched) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.common.bulk.log.info.noTopicsMatch", kafkaNameTmplPair, localize.NewEntry("Pattern", opts.pattern)))
+		return nil
+	}
+
+	names := make([]string, 0, len(matched))
+	for _, topic := range matched {
+		names = append(names, topic.GetName())
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.topic.delete.log.info.topicsPreview", len(names), kafkaNameTmplPair, localize.NewEntry("Count", len(names))))
+	opts.Logger.Info()
+	for _, name := range names {
+		opts.Logger.Info("  " + name)
+	}
+	opts.Logger.Info()
+
+	if !opts.force {
+		confirmed, err := bulkutil.Confirm(opts.localizer)
+		if err != nil {
 			return err
 		}
+		if !confirmed {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.common.bulk.log.debug.notConfirmed"))
+			return nil
+		}
 	}
 
-	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicDeleted", topicNameTmplPair, kafkaNameTmplPair))
+	results := bulkutil.Run(names, opts.continueOnError, func(name string) error {
+		return deleteTopic(opts, api, kafkaInstance, name)
+	})
 
-	return nil
+	return topiccmdutil.PrintBulkResults(opts.IO.Out, results, opts.localizer, opts.localizer.MustLocalize("kafka.topic.delete.bulk.status.deleted"))
+}
+
+// deleteTopic performs the delete topic API request
+func deleteTopic(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest, name string) error {
+	httpRes, err := api.TopicsApi.DeleteTopic(opts.Context, name).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err == nil {
+		return nil
+	}
+
+	if httpRes == nil {
+		return err
+	}
+
+	topicNameTmplPair := localize.NewEntry("TopicName", name)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+	operationTmplPair := localize.NewEntry("Operation", "delete")
+	switch httpRes.StatusCode {
+	case http.StatusNotFound:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
+	case http.StatusUnauthorized:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+	case http.StatusForbidden:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+	case http.StatusInternalServerError:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+	case http.StatusServiceUnavailable:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+	default:
+		return err
+	}
 }
@@ -4,6 +4,9 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/Shopify/sarama"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messagecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
 	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
@@ -14,6 +17,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +25,10 @@ type options struct {
 	name         string
 	kafkaID      string
 	outputFormat string
+	showDefaults bool
+	partitions   bool
+
+	credentialsFile string
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -77,6 +85,9 @@ func NewDescribeTopicCommand(f *factory.Factory) *cobra.Command {
 	flags := flagutil.NewFlagSet(cmd, opts.localizer)
 
 	flags.AddOutput(&opts.outputFormat)
+	flags.BoolVar(&opts.showDefaults, "show-defaults", false, opts.localizer.MustLocalize("kafka.topic.describe.flag.showDefaults.description"))
+	flags.BoolVar(&opts.partitions, "partitions", false, opts.localizer.MustLocalize("kafka.topic.describe.flag.partitions.description"))
+	flags.StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.credentialsFile.description"))
 
 	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.output.description"))
 	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -131,5 +142,53 @@ func runCmd(opts *options) error {
 		}
 	}
 
+	if opts.partitions {
+		return describePartitions(opts, kafkaInstance.GetBootstrapServerHost(), &topicResponse)
+	}
+
+	if !opts.showDefaults {
+		topicResponse.SetConfig(topiccmdutil.FilterDefaultConfigs(topicResponse.GetConfig()))
+	}
+
 	return dump.Formatted(opts.IO.Out, opts.outputFormat, topicResponse)
 }
+
+// describePartitions prints the replicas of the partitions of the topic,
+// along with their log offsets and sizes when they can be read from the Kafka instance
+func describePartitions(opts *options, bootstrapServer string, topic *kafkainstanceclient.Topic) error {
+	infos := topiccmdutil.NewPartitionInfos(topic.GetPartitions())
+
+	// the configuration is loaded after the connection is created,
+	// so that it contains the refreshed access token
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	tokenSource, err := messagecmdutil.NewTokenSource(opts.Context, cfg, opts.credentialsFile)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+	}
+
+	client, err := sarama.NewClient([]string{bootstrapServer}, messagecmdutil.NewClientConfig(tokenSource))
+	if err != nil {
+		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.common.log.debug.partitionLogsNotAvailable", localize.NewEntry("ErrorMessage", err)))
+	} else {
+		defer client.Close()
+
+		offsetsErr, sizesErr := topiccmdutil.ReadLogs(topiccmdutil.NewLogReader(client), opts.name, infos)
+		for _, logErr := range []error{offsetsErr, sizesErr} {
+			if logErr != nil {
+				opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.common.log.debug.partitionLogsNotAvailable", localize.NewEntry("ErrorMessage", logErr)))
+			}
+		}
+	}
+
+	if opts.outputFormat != "" {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, infos)
+	}
+
+	dump.Table(opts.IO.Out, topiccmdutil.MapPartitionsToTableRows(infos))
+
+	return nil
+}
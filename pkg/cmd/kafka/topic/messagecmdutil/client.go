@@ -0,0 +1,90 @@
+// Package messagecmdutil contains helpers for producing and consuming
+// messages to and from Kafka topics using the Kafka protocol
+package messagecmdutil
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/Shopify/sarama"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccountutil/credentials"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// tokenProvider supplies OAuth bearer tokens to the Kafka client
+// for SASL/OAUTHBEARER authentication
+type tokenProvider struct {
+	tokenSource oauth2.TokenSource
+}
+
+// Token returns a valid access token, refreshing it when needed
+func (p *tokenProvider) Token() (*sarama.AccessToken, error) {
+	token, err := p.tokenSource.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sarama.AccessToken{Token: token.AccessToken}, nil
+}
+
+// NewUserTokenSource returns a token source which authenticates
+// with the MAS-SSO access token of the logged-in user
+func NewUserTokenSource(accessToken string) oauth2.TokenSource {
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
+}
+
+// NewServiceAccountTokenSource returns a token source which authenticates
+// with the client credentials of a service account
+func NewServiceAccountTokenSource(ctx context.Context, creds *credentials.Credentials, tokenURL string) oauth2.TokenSource {
+	cfg := &clientcredentials.Config{
+		ClientID:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+		TokenURL:     tokenURL,
+	}
+
+	return cfg.TokenSource(ctx)
+}
+
+// NewTokenSource returns the token source used to authenticate with a Kafka instance.
+// When a credentials file is provided the service account in the file is used,
+// otherwise the MAS-SSO access token of the logged-in user
+func NewTokenSource(ctx context.Context, cfg *config.Config, credentialsFile string) (oauth2.TokenSource, error) {
+	if credentialsFile == "" {
+		return NewUserTokenSource(cfg.MasAccessToken), nil
+	}
+
+	creds, err := credentials.Read(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenURL := creds.TokenURL
+	if cfg.MasAuthURL != "" {
+		tokenURL = cfg.MasAuthURL + "/protocol/openid-connect/token"
+	}
+
+	return NewServiceAccountTokenSource(ctx, creds, tokenURL), nil
+}
+
+// NewClientConfig creates the Kafka client configuration used to connect to
+// the bootstrap server of a Kafka instance over TLS with SASL/OAUTHBEARER
+func NewClientConfig(tokenSource oauth2.TokenSource) *sarama.Config {
+	cfg := sarama.NewConfig()
+	cfg.ClientID = "rhoas-cli"
+	cfg.Version = sarama.V2_6_0_0
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Producer.Partitioner = NewPartitioner
+
+	cfg.Net.TLS.Enable = true
+	cfg.Net.TLS.Config = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	cfg.Net.SASL.Enable = true
+	cfg.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+	cfg.Net.SASL.TokenProvider = &tokenProvider{tokenSource: tokenSource}
+
+	return cfg
+}
@@ -0,0 +1,242 @@
+package messagecmdutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/linkedin/goavro/v2"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Codec converts message values between JSON and the encoding of a schema
+type Codec interface {
+	// Encode converts a JSON value to the encoding of the schema
+	Encode(value []byte) ([]byte, error)
+	// Decode converts a value in the encoding of the schema to JSON
+	Decode(data []byte) ([]byte, error)
+}
+
+// NewCodec creates the codec for the artifact type of the schema
+func NewCodec(schema *Schema) (Codec, error) {
+	artifactType := schema.ArtifactType
+	if artifactType == "" {
+		artifactType = DetectArtifactType(schema.Content)
+	}
+
+	switch artifactType {
+	case AvroArtifactType:
+		return newAvroCodec(schema.Content)
+	case ProtobufArtifactType:
+		return newProtobufCodec(schema.Content)
+	case JSONSchemaArtifactType:
+		return newJSONSchemaCodec(schema.Content)
+	default:
+		return nil, fmt.Errorf("unsupported schema artifact type %q", artifactType)
+	}
+}
+
+// avroCodec encodes values in the Avro binary encoding
+type avroCodec struct {
+	codec *goavro.Codec
+}
+
+func newAvroCodec(content []byte) (*avroCodec, error) {
+	codec, err := goavro.NewCodec(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+
+	return &avroCodec{codec: codec}, nil
+}
+
+func (c *avroCodec) Encode(value []byte) ([]byte, error) {
+	native, _, err := c.codec.NativeFromTextual(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.codec.BinaryFromNative(nil, native)
+}
+
+func (c *avroCodec) Decode(data []byte) ([]byte, error) {
+	native, _, err := c.codec.NativeFromBinary(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.codec.TextualFromNative(nil, native)
+}
+
+// protobufCodec encodes values in the Protobuf binary encoding.
+// As in the Apicurio serializer, the encoded message is preceded by a
+// length-delimited reference to the name of the message type
+type protobufCodec struct {
+	file *desc.FileDescriptor
+}
+
+const protobufSchemaFile = "schema.proto"
+
+func newProtobufCodec(content []byte) (*protobufCodec, error) {
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			protobufSchemaFile: string(content),
+		}),
+	}
+
+	files, err := parser.ParseFiles(protobufSchemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Protobuf schema: %w", err)
+	}
+
+	if len(files[0].GetMessageTypes()) == 0 {
+		return nil, errors.New("invalid Protobuf schema: no message types are defined")
+	}
+
+	return &protobufCodec{file: files[0]}, nil
+}
+
+func (c *protobufCodec) Encode(value []byte) ([]byte, error) {
+	// the first message type of the schema is used
+	md := c.file.GetMessageTypes()[0]
+
+	msg := dynamic.NewMessage(md)
+	if err := msg.UnmarshalJSON(value); err != nil {
+		return nil, err
+	}
+
+	data, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(encodeProtobufRef(md.GetName()), data...), nil
+}
+
+func (c *protobufCodec) Decode(data []byte) ([]byte, error) {
+	refData, n := protowire.ConsumeBytes(data)
+	if n < 0 {
+		return nil, protowire.ParseError(n)
+	}
+
+	name, err := decodeProtobufRef(refData)
+	if err != nil {
+		return nil, err
+	}
+
+	md := c.findMessage(name)
+	if md == nil {
+		return nil, fmt.Errorf("message type %q not found in Protobuf schema", name)
+	}
+
+	msg := dynamic.NewMessage(md)
+	if err = msg.Unmarshal(data[n:]); err != nil {
+		return nil, err
+	}
+
+	return msg.MarshalJSON()
+}
+
+// findMessage finds a message type by its simple or fully qualified name
+func (c *protobufCodec) findMessage(name string) *desc.MessageDescriptor {
+	if md := c.file.FindMessage(name); md != nil {
+		return md
+	}
+	if pkg := c.file.GetPackage(); pkg != "" {
+		if md := c.file.FindMessage(pkg + "." + name); md != nil {
+			return md
+		}
+	}
+	for _, md := range c.file.GetMessageTypes() {
+		if md.GetName() == name {
+			return md
+		}
+	}
+
+	return nil
+}
+
+// encodeProtobufRef encodes the reference to a message type as a
+// length-delimited message with the name in field 1
+func encodeProtobufRef(name string) []byte {
+	ref := protowire.AppendTag(nil, 1, protowire.BytesType)
+	ref = protowire.AppendString(ref, name)
+
+	return protowire.AppendBytes(nil, ref)
+}
+
+func decodeProtobufRef(data []byte) (string, error) {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return "", protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if num == 1 && typ == protowire.BytesType {
+			name, m := protowire.ConsumeString(data)
+			if m < 0 {
+				return "", protowire.ParseError(m)
+			}
+			return name, nil
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, data)
+		if m < 0 {
+			return "", protowire.ParseError(m)
+		}
+		data = data[m:]
+	}
+
+	return "", errors.New("invalid Protobuf message reference")
+}
+
+// jsonSchemaCodec validates JSON values against a JSON Schema
+type jsonSchemaCodec struct {
+	schema *gojsonschema.Schema
+}
+
+func newJSONSchemaCodec(content []byte) (*jsonSchemaCodec, error) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+
+	return &jsonSchemaCodec{schema: schema}, nil
+}
+
+func (c *jsonSchemaCodec) Encode(value []byte) ([]byte, error) {
+	result, err := c.schema.Validate(gojsonschema.NewBytesLoader(value))
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Valid() {
+		violations := make([]string, 0, len(result.Errors()))
+		for _, violation := range result.Errors() {
+			violations = append(violations, violation.String())
+		}
+		return nil, fmt.Errorf("value does not match the JSON Schema: %v", strings.Join(violations, ", "))
+	}
+
+	var buf bytes.Buffer
+	if err = json.Compact(&buf, value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *jsonSchemaCodec) Decode(data []byte) ([]byte, error) {
+	if !json.Valid(data) {
+		return nil, errors.New("value is not valid JSON")
+	}
+
+	return data, nil
+}
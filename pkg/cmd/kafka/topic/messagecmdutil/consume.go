@@ -0,0 +1,326 @@
+package messagecmdutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// Record is a message consumed from a Kafka topic
+type Record struct {
+	Topic     string            `json:"topic"`
+	Partition int32             `json:"partition"`
+	Offset    int64             `json:"offset"`
+	Timestamp time.Time         `json:"timestamp"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Key       *string           `json:"key,omitempty"`
+	Value     *string           `json:"value"`
+	// Decoded is true when the value was decoded with a schema from Service Registry
+	Decoded bool `json:"decoded,omitempty"`
+}
+
+// RecordHandler is called for every record consumed from a topic
+type RecordHandler func(record *Record) error
+
+// ConsumeOptions controls where consumption starts and when it stops
+type ConsumeOptions struct {
+	Topic string
+	// Offset is an absolute offset, sarama.OffsetOldest or sarama.OffsetNewest
+	Offset int64
+	// Timestamp, when set, starts consumption from the first message produced at or after it
+	Timestamp *time.Time
+	// MaxMessages stops consumption after this number of messages, zero means no limit
+	MaxMessages int
+	// Deserializer, when set, decodes the values of messages written with a schema to JSON
+	Deserializer *Deserializer
+	// DecodeErrorHandler, when set, is called when a value cannot be decoded.
+	// The raw value is kept when it returns nil, otherwise consumption stops with the error
+	DecodeErrorHandler func(msg *sarama.ConsumerMessage, err error) error
+}
+
+// NewRecord converts a consumed message into a record
+func NewRecord(msg *sarama.ConsumerMessage) *Record {
+	record := &Record{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Timestamp: msg.Timestamp,
+	}
+
+	if msg.Key != nil {
+		key := string(msg.Key)
+		record.Key = &key
+	}
+	if msg.Value != nil {
+		value := string(msg.Value)
+		record.Value = &value
+	}
+
+	if len(msg.Headers) > 0 {
+		record.Headers = make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			record.Headers[string(header.Key)] = string(header.Value)
+		}
+	}
+
+	return record
+}
+
+// newRecord converts a consumed message into a record,
+// decoding its value when it was written with a schema.
+// Values written without a schema are kept as they are
+func (o *ConsumeOptions) newRecord(msg *sarama.ConsumerMessage) (*Record, error) {
+	record := NewRecord(msg)
+	if o.Deserializer == nil || msg.Value == nil {
+		return record, nil
+	}
+
+	decoded, ok, err := o.Deserializer.Deserialize(msg.Value, msg.Headers)
+	if err != nil {
+		err = fmt.Errorf("could not decode message at offset %v of partition %v: %w", msg.Offset, msg.Partition, err)
+		if o.DecodeErrorHandler == nil {
+			return nil, err
+		}
+		if err = o.DecodeErrorHandler(msg, err); err != nil {
+			return nil, err
+		}
+		return record, nil
+	}
+	if !ok {
+		return record, nil
+	}
+
+	value := string(decoded)
+	record.Value = &value
+	record.Decoded = true
+
+	return record, nil
+}
+
+// NewRecordPrinter returns a record handler which writes records to the writer.
+// In the JSON format each record is written as a JSON object on its own line,
+// otherwise only the record value is written
+func NewRecordPrinter(w io.Writer, format string) RecordHandler {
+	return func(record *Record) error {
+		if format == JSONFormat {
+			data, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(w, string(data))
+			return err
+		}
+
+		var value string
+		if record.Value != nil {
+			value = *record.Value
+		}
+		_, err := fmt.Fprintln(w, value)
+		return err
+	}
+}
+
+// ConsumePartitions consumes messages from every partition of the topic
+// until the context is canceled or the maximum number of messages is reached
+func ConsumePartitions(ctx context.Context, client sarama.Client, opts *ConsumeOptions, handle RecordHandler) error {
+	partitions, err := client.Partitions(opts.Topic)
+	if err != nil {
+		return err
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	messages := make(chan *sarama.ConsumerMessage)
+	errs := make(chan error, len(partitions))
+
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	for _, partition := range partitions {
+		offset, err := startOffset(client, opts, partition)
+		if err != nil {
+			return err
+		}
+
+		partitionConsumer, err := consumer.ConsumePartition(opts.Topic, partition, offset)
+		if err != nil {
+			return err
+		}
+
+		wg.Add(1)
+		go func(pc sarama.PartitionConsumer) {
+			defer wg.Done()
+			defer pc.AsyncClose()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case consumerErr, ok := <-pc.Errors():
+					if ok {
+						errs <- consumerErr
+					}
+					return
+				case msg, ok := <-pc.Messages():
+					if !ok {
+						return
+					}
+					select {
+					case messages <- msg:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}(partitionConsumer)
+	}
+
+	var count int
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-errs:
+			return err
+		case msg := <-messages:
+			record, err := opts.newRecord(msg)
+			if err != nil {
+				return err
+			}
+			if err = handle(record); err != nil {
+				return err
+			}
+			count++
+			if opts.MaxMessages > 0 && count >= opts.MaxMessages {
+				return nil
+			}
+		}
+	}
+}
+
+// ConsumeGroup consumes messages from the topic as a member of a consumer group,
+// committing the offsets of the consumed messages
+// until the context is canceled or the maximum number of messages is reached
+func ConsumeGroup(ctx context.Context, group sarama.ConsumerGroup, opts *ConsumeOptions, handle RecordHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	handler := &groupHandler{
+		opts:        opts,
+		handle:      handle,
+		maxMessages: opts.MaxMessages,
+		cancel:      cancel,
+	}
+
+	for {
+		if err := group.Consume(ctx, []string{opts.Topic}, handler); err != nil {
+			return err
+		}
+		if handler.err != nil {
+			return handler.err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// groupHandler handles the partitions claimed by a consumer group member
+type groupHandler struct {
+	opts        *ConsumeOptions
+	handle      RecordHandler
+	maxMessages int
+	cancel      context.CancelFunc
+
+	mu    sync.Mutex
+	count int
+	err   error
+}
+
+func (h *groupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *groupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if done := h.process(session, msg); done {
+				return nil
+			}
+		}
+	}
+}
+
+// process handles a single message, returning true when consumption must stop
+func (h *groupHandler) process(session sarama.ConsumerGroupSession, msg *sarama.ConsumerMessage) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.err != nil || (h.maxMessages > 0 && h.count >= h.maxMessages) {
+		return true
+	}
+
+	record, err := h.opts.newRecord(msg)
+	if err == nil {
+		err = h.handle(record)
+	}
+	if err != nil {
+		h.err = err
+		h.cancel()
+		return true
+	}
+
+	session.MarkMessage(msg, "")
+	h.count++
+
+	if h.maxMessages > 0 && h.count >= h.maxMessages {
+		session.Commit()
+		h.cancel()
+		return true
+	}
+
+	return false
+}
+
+// startOffset resolves the offset from which a partition is consumed
+func startOffset(client sarama.Client, opts *ConsumeOptions, partition int32) (int64, error) {
+	if opts.Timestamp == nil {
+		return opts.Offset, nil
+	}
+
+	millis := opts.Timestamp.UnixNano() / int64(time.Millisecond)
+	offset, err := client.GetOffset(opts.Topic, partition, millis)
+	if err != nil {
+		return 0, err
+	}
+
+	// no message was produced after the timestamp
+	if offset < 0 {
+		return sarama.OffsetNewest, nil
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,82 @@
+package messagecmdutil
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+func TestConsumePartitions(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("orders", 0, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).SetVersion(1).
+			SetOffset("orders", 0, sarama.OffsetOldest, 0).
+			SetOffset("orders", 0, sarama.OffsetNewest, 3),
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(3).
+			SetMessage("orders", 0, 0, sarama.StringEncoder("first")).
+			SetMessage("orders", 0, 1, sarama.StringEncoder("second")).
+			SetMessage("orders", 0, 2, sarama.StringEncoder("third")).
+			SetHighWaterMark("orders", 0, 3),
+	})
+
+	cfg := newTestConfig()
+
+	client, err := sarama.NewClient([]string{broker.Addr()}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	tests := []struct {
+		name   string
+		opts   *ConsumeOptions
+		format string
+		want   string
+	}{
+		{
+			name:   "from beginning",
+			opts:   &ConsumeOptions{Topic: "orders", Offset: sarama.OffsetOldest, MaxMessages: 3},
+			format: TextFormat,
+			want:   "first\nsecond\nthird\n",
+		},
+		{
+			name:   "from offset",
+			opts:   &ConsumeOptions{Topic: "orders", Offset: 1, MaxMessages: 2},
+			format: TextFormat,
+			want:   "second\nthird\n",
+		},
+		{
+			name:   "JSON output",
+			opts:   &ConsumeOptions{Topic: "orders", Offset: 2, MaxMessages: 1},
+			format: JSONFormat,
+			want:   `"partition":0,"offset":2,`,
+		},
+	}
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			var out bytes.Buffer
+			if err := ConsumePartitions(ctx, client, tt.opts, NewRecordPrinter(&out, tt.format)); err != nil {
+				t.Fatalf("ConsumePartitions() error = %v", err)
+			}
+			if ctx.Err() != nil {
+				t.Fatal("ConsumePartitions() timed out")
+			}
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("ConsumePartitions() output = %v, want %v", out.String(), tt.want)
+			}
+		})
+	}
+}
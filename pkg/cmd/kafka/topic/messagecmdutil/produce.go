@@ -0,0 +1,233 @@
+package messagecmdutil
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Shopify/sarama"
+)
+
+// valid values for the message format
+const (
+	TextFormat = "text"
+	JSONFormat = "json"
+)
+
+var ValidMessageFormats = []string{TextFormat, JSONFormat}
+
+// NoPartition indicates that the partition of a message is chosen by the partitioner
+const NoPartition int32 = -1
+
+// maximum size of a single line of input
+const maxLineSize = 1024 * 1024
+
+// InputOptions controls how lines of input are converted to messages
+type InputOptions struct {
+	Format       string
+	KeySeparator string
+	Headers      map[string]string
+	Partition    int32
+	// Serializer, when set, encodes the JSON value of each message with a schema
+	Serializer *Serializer
+}
+
+// jsonMessage is the representation of a message in the JSON input format
+type jsonMessage struct {
+	Key       *string           `json:"key"`
+	Value     json.RawMessage   `json:"value"`
+	Headers   map[string]string `json:"headers"`
+	Partition *int32            `json:"partition"`
+}
+
+// explicitPartition is set as the metadata of messages
+// which must be sent to a specific partition
+type explicitPartition int32
+
+type partitioner struct {
+	hash sarama.Partitioner
+}
+
+// NewPartitioner returns a partitioner which sends messages to their explicitly
+// requested partition, falling back to hashing the message key
+func NewPartitioner(topic string) sarama.Partitioner {
+	return &partitioner{hash: sarama.NewHashPartitioner(topic)}
+}
+
+func (p *partitioner) Partition(msg *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if partition, ok := msg.Metadata.(explicitPartition); ok {
+		if int32(partition) >= numPartitions {
+			return -1, fmt.Errorf("partition %v does not exist, the topic has %v partitions", partition, numPartitions)
+		}
+		return int32(partition), nil
+	}
+
+	return p.hash.Partition(msg, numPartitions)
+}
+
+func (p *partitioner) RequiresConsistency() bool {
+	return true
+}
+
+// ParseHeaders parses a list of headers in the "key=value" format
+func ParseHeaders(headers []string) (map[string]string, error) {
+	parsed := make(map[string]string, len(headers))
+	for _, header := range headers {
+		entry := strings.SplitN(header, "=", 2)
+		if len(entry) != 2 || entry[0] == "" {
+			return nil, fmt.Errorf(`invalid header "%v", headers must be in the "key=value" format`, header)
+		}
+		parsed[entry[0]] = entry[1]
+	}
+
+	return parsed, nil
+}
+
+// ParseMessage converts a line of input into a message for the topic.
+// When a serializer is set, the message value must be a JSON value matching its schema
+func ParseMessage(topic string, line string, opts *InputOptions) (*sarama.ProducerMessage, error) {
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+	}
+
+	headers := make(map[string]string, len(opts.Headers))
+	for k, v := range opts.Headers {
+		headers[k] = v
+	}
+
+	partition := opts.Partition
+
+	switch opts.Format {
+	case JSONFormat:
+		var jsonMsg jsonMessage
+		if err := json.Unmarshal([]byte(line), &jsonMsg); err != nil {
+			return nil, fmt.Errorf("invalid JSON message: %w", err)
+		}
+		if jsonMsg.Key != nil {
+			msg.Key = sarama.StringEncoder(*jsonMsg.Key)
+		}
+		if opts.Serializer != nil {
+			value, schemaHeaders, err := opts.Serializer.Serialize(jsonMsg.Value)
+			if err != nil {
+				return nil, err
+			}
+			msg.Value = sarama.ByteEncoder(value)
+			msg.Headers = schemaHeaders
+		} else {
+			value, err := decodeJSONValue(jsonMsg.Value)
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				msg.Value = sarama.ByteEncoder(value)
+			}
+		}
+		for k, v := range jsonMsg.Headers {
+			headers[k] = v
+		}
+		if jsonMsg.Partition != nil {
+			partition = *jsonMsg.Partition
+		}
+	default:
+		value := line
+		if opts.KeySeparator != "" {
+			entry := strings.SplitN(line, opts.KeySeparator, 2)
+			if len(entry) != 2 {
+				return nil, fmt.Errorf(`key separator "%v" not found in message "%v"`, opts.KeySeparator, line)
+			}
+			msg.Key = sarama.StringEncoder(entry[0])
+			value = entry[1]
+		}
+		if opts.Serializer != nil {
+			serialized, schemaHeaders, err := opts.Serializer.Serialize([]byte(value))
+			if err != nil {
+				return nil, err
+			}
+			msg.Value = sarama.ByteEncoder(serialized)
+			msg.Headers = schemaHeaders
+		} else {
+			msg.Value = sarama.StringEncoder(value)
+		}
+	}
+
+	if partition >= 0 {
+		msg.Metadata = explicitPartition(partition)
+	}
+
+	msg.Headers = append(msg.Headers, toRecordHeaders(headers)...)
+
+	return msg, nil
+}
+
+// Produce reads messages line by line from the reader and sends them to the topic.
+// Empty lines are skipped. It returns the number of messages which were produced
+func Produce(producer sarama.SyncProducer, topic string, r io.Reader, opts *InputOptions) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	var count int
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		msg, err := ParseMessage(topic, line, opts)
+		if err != nil {
+			return count, err
+		}
+
+		if _, _, err = producer.SendMessage(msg); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, scanner.Err()
+}
+
+// decodeJSONValue returns the raw value of a JSON message.
+// String values are unquoted, other JSON values are kept as compact JSON
+func decodeJSONValue(raw json.RawMessage) ([]byte, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		return []byte(s), nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func toRecordHeaders(headers map[string]string) []sarama.RecordHeader {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	recordHeaders := make([]sarama.RecordHeader, 0, len(keys))
+	for _, k := range keys {
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{Key: []byte(k), Value: []byte(headers[k])})
+	}
+
+	return recordHeaders
+}
@@ -0,0 +1,161 @@
+package messagecmdutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Shopify/sarama"
+)
+
+func TestParseMessage(t *testing.T) {
+	type want struct {
+		key       string
+		value     string
+		headers   int
+		partition interface{}
+	}
+
+	tests := []struct {
+		name    string
+		line    string
+		opts    *InputOptions
+		want    want
+		wantErr bool
+	}{
+		{
+			name: "text message",
+			line: "hello world",
+			opts: &InputOptions{Format: TextFormat, Partition: NoPartition},
+			want: want{value: "hello world"},
+		},
+		{
+			name: "text message with key separator",
+			line: "id-1:hello",
+			opts: &InputOptions{Format: TextFormat, KeySeparator: ":", Partition: NoPartition},
+			want: want{key: "id-1", value: "hello"},
+		},
+		{
+			name:    "text message without key separator",
+			line:    "hello",
+			opts:    &InputOptions{Format: TextFormat, KeySeparator: ":", Partition: NoPartition},
+			wantErr: true,
+		},
+		{
+			name: "text message with headers and partition",
+			line: "hello",
+			opts: &InputOptions{Format: TextFormat, Headers: map[string]string{"source": "cli"}, Partition: 2},
+			want: want{value: "hello", headers: 1, partition: explicitPartition(2)},
+		},
+		{
+			name: "JSON message with string value",
+			line: `{"key":"id-1","value":"hello","headers":{"a":"1","b":"2"},"partition":1}`,
+			opts: &InputOptions{Format: JSONFormat, Partition: NoPartition},
+			want: want{key: "id-1", value: "hello", headers: 2, partition: explicitPartition(1)},
+		},
+		{
+			name: "JSON message with object value",
+			line: `{"value": {"id": 1, "name": "order"}}`,
+			opts: &InputOptions{Format: JSONFormat, Partition: NoPartition},
+			want: want{value: `{"id":1,"name":"order"}`},
+		},
+		{
+			name:    "invalid JSON message",
+			line:    `{"value":`,
+			opts:    &InputOptions{Format: JSONFormat, Partition: NoPartition},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := ParseMessage("orders", tt.line, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if tt.want.key != "" {
+				if msg.Key == nil {
+					t.Fatalf("ParseMessage() key = nil, want %v", tt.want.key)
+				}
+				if key, _ := msg.Key.Encode(); string(key) != tt.want.key {
+					t.Errorf("ParseMessage() key = %v, want %v", string(key), tt.want.key)
+				}
+			} else if msg.Key != nil {
+				t.Errorf("ParseMessage() key = %v, want nil", msg.Key)
+			}
+			if value, _ := msg.Value.Encode(); string(value) != tt.want.value {
+				t.Errorf("ParseMessage() value = %v, want %v", string(value), tt.want.value)
+			}
+			if len(msg.Headers) != tt.want.headers {
+				t.Errorf("ParseMessage() headers = %v, want %v", len(msg.Headers), tt.want.headers)
+			}
+			if msg.Metadata != tt.want.partition {
+				t.Errorf("ParseMessage() partition = %v, want %v", msg.Metadata, tt.want.partition)
+			}
+		})
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := ParseHeaders([]string{"source=cli", "trace=a=b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headers["source"] != "cli" || headers["trace"] != "a=b" {
+		t.Errorf("ParseHeaders() = %v", headers)
+	}
+
+	if _, err = ParseHeaders([]string{"invalid"}); err == nil {
+		t.Error("ParseHeaders() expected error for header without value")
+	}
+}
+
+func TestProduce(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("orders", 0, broker.BrokerID()).
+			SetLeader("orders", 1, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetVersion(2),
+	})
+
+	cfg := newTestConfig()
+
+	producer, err := sarama.NewSyncProducer([]string{broker.Addr()}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer producer.Close()
+
+	input := strings.NewReader("k1=first\n\nk2=second\n")
+	count, err := Produce(producer, "orders", input, &InputOptions{Format: TextFormat, KeySeparator: "=", Partition: 1})
+	if err != nil {
+		t.Fatalf("Produce() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Produce() count = %v, want 2", count)
+	}
+
+	_, err = Produce(producer, "orders", strings.NewReader("hello\n"), &InputOptions{Format: TextFormat, Partition: 5})
+	if err == nil {
+		t.Error("Produce() expected error for partition which does not exist")
+	}
+}
+
+// newTestConfig returns a client configuration
+// for the protocol versions supported by the mock broker
+func newTestConfig() *sarama.Config {
+	cfg := NewClientConfig(nil)
+	cfg.Net.TLS.Enable = false
+	cfg.Net.SASL.Enable = false
+	cfg.Version = sarama.V0_10_2_0
+	cfg.ApiVersionsRequest = false
+	cfg.Producer.Retry.Max = 0
+	return cfg
+}
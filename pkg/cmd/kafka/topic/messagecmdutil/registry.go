@@ -0,0 +1,106 @@
+package messagecmdutil
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+// artifactTypeHeader is set by Service Registry on responses containing artifact content
+const artifactTypeHeader = "X-Registry-ArtifactType"
+
+// registrySchemaResolver fetches schemas from a Service Registry instance
+type registrySchemaResolver struct {
+	ctx context.Context
+	api *registryinstanceclient.APIClient
+}
+
+// NewRegistrySchemaResolver creates a schema resolver for a Service Registry instance
+func NewRegistrySchemaResolver(ctx context.Context, api *registryinstanceclient.APIClient) SchemaResolver {
+	return &registrySchemaResolver{
+		ctx: ctx,
+		api: api,
+	}
+}
+
+func (r *registrySchemaResolver) ResolveArtifact(groupID string, artifactID string) (*Schema, error) {
+	metadata, httpRes, err := r.api.MetadataApi.GetArtifactMetaData(r.ctx, groupID, artifactID).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, registrycmdutil.TransformInstanceError(err)
+	}
+
+	dataFile, contentRes, err := r.api.ArtifactsApi.GetLatestArtifact(r.ctx, groupID, artifactID).Execute()
+	if contentRes != nil {
+		defer contentRes.Body.Close()
+	}
+	if err != nil {
+		return nil, registrycmdutil.TransformInstanceError(err)
+	}
+
+	content, err := readContent(dataFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{
+		GlobalID:     metadata.GetGlobalId(),
+		ArtifactType: string(metadata.GetType()),
+		Content:      content,
+	}, nil
+}
+
+func (r *registrySchemaResolver) ResolveGlobalID(globalID int64) (*Schema, error) {
+	dataFile, httpRes, err := r.api.ArtifactsApi.GetContentByGlobalId(r.ctx, globalID).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, registrycmdutil.TransformInstanceError(err)
+	}
+
+	return newResolvedSchema(globalID, dataFile, httpRes)
+}
+
+func (r *registrySchemaResolver) ResolveContentID(contentID int64) (*Schema, error) {
+	dataFile, httpRes, err := r.api.ArtifactsApi.GetContentById(r.ctx, contentID).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, registrycmdutil.TransformInstanceError(err)
+	}
+
+	return newResolvedSchema(0, dataFile, httpRes)
+}
+
+func newResolvedSchema(globalID int64, dataFile *os.File, httpRes *http.Response) (*Schema, error) {
+	content, err := readContent(dataFile)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{
+		GlobalID: globalID,
+		Content:  content,
+	}
+	if httpRes != nil {
+		schema.ArtifactType = httpRes.Header.Get(artifactTypeHeader)
+	}
+
+	return schema, nil
+}
+
+// readContent reads the artifact content which the API client saves to a temporary file
+func readContent(dataFile *os.File) ([]byte, error) {
+	defer os.Remove(dataFile.Name())
+	defer dataFile.Close()
+
+	return ioutil.ReadFile(dataFile.Name())
+}
@@ -0,0 +1,221 @@
+package messagecmdutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Shopify/sarama"
+)
+
+// artifact types of the schemas which can be used to serialize messages
+const (
+	AvroArtifactType       = "AVRO"
+	ProtobufArtifactType   = "PROTOBUF"
+	JSONSchemaArtifactType = "JSON"
+)
+
+// Apicurio wire format
+const (
+	// magicByte is the first byte of a message value which starts with the schema ID
+	magicByte byte = 0x0
+	// idSize is the size in bytes of the schema ID in the message value and headers
+	idSize = 8
+
+	GlobalIDHeader  = "apicurio.value.globalId"
+	ContentIDHeader = "apicurio.value.contentId"
+)
+
+// Schema is a schema artifact fetched from Service Registry
+type Schema struct {
+	GlobalID     int64
+	ArtifactType string
+	Content      []byte
+}
+
+// SchemaResolver fetches the schemas used to serialize and deserialize messages
+type SchemaResolver interface {
+	// ResolveArtifact returns the latest version of an artifact
+	ResolveArtifact(groupID string, artifactID string) (*Schema, error)
+	// ResolveGlobalID returns the artifact version with the given global ID
+	ResolveGlobalID(globalID int64) (*Schema, error)
+	// ResolveContentID returns the artifact content with the given content ID
+	ResolveContentID(contentID int64) (*Schema, error)
+}
+
+// Serializer encodes JSON message values with a schema in the Apicurio wire format.
+// The global ID of the schema is either prefixed to the value after a magic byte,
+// or sent in the message headers
+type Serializer struct {
+	schema     *Schema
+	codec      Codec
+	useHeaders bool
+}
+
+// NewSerializer creates a serializer for the schema
+func NewSerializer(schema *Schema, useHeaders bool) (*Serializer, error) {
+	codec, err := NewCodec(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Serializer{
+		schema:     schema,
+		codec:      codec,
+		useHeaders: useHeaders,
+	}, nil
+}
+
+// Serialize encodes a JSON value, returning the message value and the headers to add to the message
+func (s *Serializer) Serialize(value []byte) ([]byte, []sarama.RecordHeader, error) {
+	data, err := s.codec.Encode(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := make([]byte, idSize)
+	binary.BigEndian.PutUint64(id, uint64(s.schema.GlobalID))
+
+	if s.useHeaders {
+		return data, []sarama.RecordHeader{{Key: []byte(GlobalIDHeader), Value: id}}, nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(magicByte)
+	buf.Write(id)
+	buf.Write(data)
+
+	return buf.Bytes(), nil, nil
+}
+
+// Deserializer decodes message values written in the Apicurio wire format to JSON.
+// The schemas are fetched from the resolver the first time each ID is seen
+type Deserializer struct {
+	resolver SchemaResolver
+
+	mu     sync.Mutex
+	codecs map[string]Codec
+}
+
+// NewDeserializer creates a deserializer which fetches schemas from the resolver
+func NewDeserializer(resolver SchemaResolver) *Deserializer {
+	return &Deserializer{
+		resolver: resolver,
+		codecs:   map[string]Codec{},
+	}
+}
+
+// Deserialize detects the schema ID of a message and decodes its value to JSON.
+// The schema ID is read from the message headers or from the start of the value.
+// It returns false when the message was not written with a schema
+func (d *Deserializer) Deserialize(value []byte, headers []*sarama.RecordHeader) ([]byte, bool, error) {
+	var (
+		globalID, contentID int64
+		payload             = value
+	)
+
+	for _, header := range headers {
+		if len(header.Value) != idSize {
+			continue
+		}
+		switch string(header.Key) {
+		case GlobalIDHeader:
+			globalID = int64(binary.BigEndian.Uint64(header.Value))
+		case ContentIDHeader:
+			contentID = int64(binary.BigEndian.Uint64(header.Value))
+		}
+	}
+
+	if globalID == 0 && contentID == 0 {
+		if len(value) <= idSize || value[0] != magicByte {
+			return nil, false, nil
+		}
+		globalID = int64(binary.BigEndian.Uint64(value[1 : idSize+1]))
+		payload = value[idSize+1:]
+	}
+
+	codec, err := d.codec(globalID, contentID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	decoded, err := codec.Decode(payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return decoded, true, nil
+}
+
+// codec returns the cached codec for a schema ID, fetching the schema when needed
+func (d *Deserializer) codec(globalID int64, contentID int64) (Codec, error) {
+	key := fmt.Sprintf("global:%v", globalID)
+	if globalID == 0 {
+		key = fmt.Sprintf("content:%v", contentID)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if codec, ok := d.codecs[key]; ok {
+		return codec, nil
+	}
+
+	var (
+		schema *Schema
+		err    error
+	)
+	if globalID != 0 {
+		schema, err = d.resolver.ResolveGlobalID(globalID)
+	} else {
+		schema, err = d.resolver.ResolveContentID(contentID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	codec, err := NewCodec(schema)
+	if err != nil {
+		return nil, err
+	}
+	d.codecs[key] = codec
+
+	return codec, nil
+}
+
+// DetectArtifactType infers the artifact type of a schema from its content
+func DetectArtifactType(content []byte) string {
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 {
+		return ""
+	}
+
+	// Protobuf schemas are not JSON documents
+	if trimmed[0] != '{' && trimmed[0] != '[' && trimmed[0] != '"' {
+		return ProtobufArtifactType
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(trimmed, &doc); err != nil {
+		// primitive and union Avro schemas
+		return AvroArtifactType
+	}
+
+	if _, ok := doc["$schema"]; ok {
+		return JSONSchemaArtifactType
+	}
+
+	if schemaType, ok := doc["type"].(string); ok {
+		switch strings.ToLower(schemaType) {
+		case "record", "enum", "fixed", "array", "map":
+			if _, hasProperties := doc["properties"]; !hasProperties {
+				return AvroArtifactType
+			}
+		}
+	}
+
+	return JSONSchemaArtifactType
+}
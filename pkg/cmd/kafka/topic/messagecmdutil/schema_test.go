@@ -0,0 +1,197 @@
+package messagecmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Shopify/sarama"
+)
+
+const (
+	avroSchema = `{
+  "type": "record",
+  "name": "Order",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "amount", "type": "int"}
+  ]
+}`
+
+	protobufSchema = `syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+  int32 amount = 2;
+}`
+
+	jsonSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "id": {"type": "string"},
+    "amount": {"type": "integer"}
+  },
+  "required": ["id"]
+}`
+)
+
+// fakeSchemaResolver resolves schemas from memory
+type fakeSchemaResolver struct {
+	schemas map[int64]*Schema
+}
+
+func (r *fakeSchemaResolver) ResolveArtifact(groupID string, artifactID string) (*Schema, error) {
+	return nil, fmt.Errorf("artifact %v/%v not found", groupID, artifactID)
+}
+
+func (r *fakeSchemaResolver) ResolveGlobalID(globalID int64) (*Schema, error) {
+	schema, ok := r.schemas[globalID]
+	if !ok {
+		return nil, fmt.Errorf("no artifact with global ID %v", globalID)
+	}
+	return schema, nil
+}
+
+func (r *fakeSchemaResolver) ResolveContentID(contentID int64) (*Schema, error) {
+	return r.ResolveGlobalID(contentID)
+}
+
+func TestSerializeDeserialize(t *testing.T) {
+	schemas := map[int64]*Schema{
+		1: {GlobalID: 1, ArtifactType: AvroArtifactType, Content: []byte(avroSchema)},
+		2: {GlobalID: 2, ArtifactType: ProtobufArtifactType, Content: []byte(protobufSchema)},
+		3: {GlobalID: 3, Content: []byte(jsonSchema)},
+	}
+	deserializer := NewDeserializer(&fakeSchemaResolver{schemas: schemas})
+
+	tests := []struct {
+		name       string
+		globalID   int64
+		useHeaders bool
+	}{
+		{name: "Avro with magic byte", globalID: 1},
+		{name: "Avro with headers", globalID: 1, useHeaders: true},
+		{name: "Protobuf with magic byte", globalID: 2},
+		{name: "Protobuf with headers", globalID: 2, useHeaders: true},
+		{name: "JSON Schema with magic byte", globalID: 3},
+	}
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			serializer, err := NewSerializer(schemas[tt.globalID], tt.useHeaders)
+			if err != nil {
+				t.Fatalf("NewSerializer() error = %v", err)
+			}
+
+			value, headers, err := serializer.Serialize([]byte(`{"id": "order-1", "amount": 10}`))
+			if err != nil {
+				t.Fatalf("Serialize() error = %v", err)
+			}
+			if tt.useHeaders == (len(headers) == 0) {
+				t.Fatalf("Serialize() headers = %v, useHeaders %v", headers, tt.useHeaders)
+			}
+			if !tt.useHeaders && value[0] != magicByte {
+				t.Fatalf("Serialize() value does not start with the magic byte")
+			}
+
+			recordHeaders := make([]*sarama.RecordHeader, 0, len(headers))
+			for i := range headers {
+				recordHeaders = append(recordHeaders, &headers[i])
+			}
+
+			decoded, ok, err := deserializer.Deserialize(value, recordHeaders)
+			if err != nil || !ok {
+				t.Fatalf("Deserialize() ok = %v, error = %v", ok, err)
+			}
+
+			var got map[string]interface{}
+			if err = json.Unmarshal(decoded, &got); err != nil {
+				t.Fatalf("Deserialize() returned invalid JSON %v", string(decoded))
+			}
+			want := map[string]interface{}{"id": "order-1", "amount": float64(10)}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Deserialize() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSerializeInvalidValue(t *testing.T) {
+	serializer, err := NewSerializer(&Schema{GlobalID: 3, ArtifactType: JSONSchemaArtifactType, Content: []byte(jsonSchema)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = serializer.Serialize([]byte(`{"amount": "ten"}`)); err == nil {
+		t.Error("Serialize() expected error for value which does not match the schema")
+	}
+}
+
+func TestDeserializeWithoutSchema(t *testing.T) {
+	deserializer := NewDeserializer(&fakeSchemaResolver{})
+
+	_, ok, err := deserializer.Deserialize([]byte("hello world"), nil)
+	if err != nil || ok {
+		t.Errorf("Deserialize() ok = %v, error = %v, want a value without schema", ok, err)
+	}
+}
+
+func TestNewRecordDecodeError(t *testing.T) {
+	opts := &ConsumeOptions{Deserializer: NewDeserializer(&fakeSchemaResolver{})}
+	msg := &sarama.ConsumerMessage{
+		Topic: "orders",
+		Value: []byte{magicByte, 0, 0, 0, 0, 0, 0, 0, 7, 'x'},
+	}
+
+	if _, err := opts.newRecord(msg); err == nil {
+		t.Error("newRecord() expected error for value with unknown schema")
+	}
+}
+
+func TestNewRecordDecodeErrorHandler(t *testing.T) {
+	var handled error
+	opts := &ConsumeOptions{
+		Deserializer: NewDeserializer(&fakeSchemaResolver{}),
+		DecodeErrorHandler: func(_ *sarama.ConsumerMessage, err error) error {
+			handled = err
+			return nil
+		},
+	}
+	value := []byte{magicByte, 0, 0, 0, 0, 0, 0, 0, 7, 'x'}
+	msg := &sarama.ConsumerMessage{Topic: "orders", Value: value}
+
+	record, err := opts.newRecord(msg)
+	if err != nil {
+		t.Fatalf("newRecord() error = %v", err)
+	}
+	if handled == nil {
+		t.Error("newRecord() did not call the decode error handler")
+	}
+	if record.Decoded || record.Value == nil || *record.Value != string(value) {
+		t.Errorf("newRecord() = %+v, want the raw value", record)
+	}
+}
+
+func TestDetectArtifactType(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "Avro record", content: avroSchema, want: AvroArtifactType},
+		{name: "Avro primitive", content: `"string"`, want: AvroArtifactType},
+		{name: "Protobuf", content: protobufSchema, want: ProtobufArtifactType},
+		{name: "JSON Schema", content: jsonSchema, want: JSONSchemaArtifactType},
+	}
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectArtifactType([]byte(tt.content)); got != tt.want {
+				t.Errorf("DetectArtifactType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
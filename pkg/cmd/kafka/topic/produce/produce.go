@@ -0,0 +1,266 @@
+package produce
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/Shopify/sarama"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messagecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	topicName       string
+	kafkaID         string
+	file            string
+	format          string
+	keySeparator    string
+	headers         []string
+	partition       int32
+	credentialsFile string
+	registryID      string
+	schemaArtifact  string
+	schemaGroup     string
+	schemaHeaders   bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewProduceTopicCommand gets a new command for producing messages to a kafka topic.
+func NewProduceTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "produce",
+		Short:   opts.localizer.MustLocalize("kafka.topic.produce.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.produce.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.produce.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if !flagutil.IsValidInput(opts.format, messagecmdutil.ValidMessageFormats...) {
+				return flagutil.InvalidValueError("format", opts.format, messagecmdutil.ValidMessageFormats...)
+			}
+
+			if opts.partition < messagecmdutil.NoPartition {
+				return opts.localizer.MustLocalizeError("kafka.topic.produce.error.invalidPartition", localize.NewEntry("Partition", opts.partition))
+			}
+
+			if opts.kafkaID != "" {
+				return runCmd(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.topic.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runCmd(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.StringVar(&opts.topicName, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.name.description"))
+	flags.StringVar(&opts.file, "file", "", opts.localizer.MustLocalize("kafka.topic.produce.flag.file.description"))
+	flags.StringVar(&opts.format, "format", messagecmdutil.TextFormat, flagutil.FlagDescription(opts.localizer, "kafka.topic.produce.flag.format.description", messagecmdutil.ValidMessageFormats...))
+	flags.StringVar(&opts.keySeparator, "key-separator", "", opts.localizer.MustLocalize("kafka.topic.produce.flag.keySeparator.description"))
+	flags.StringArrayVar(&opts.headers, "header", []string{}, opts.localizer.MustLocalize("kafka.topic.produce.flag.header.description"))
+	flags.Int32Var(&opts.partition, "partition", messagecmdutil.NoPartition, opts.localizer.MustLocalize("kafka.topic.produce.flag.partition.description"))
+	flags.StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.credentialsFile.description"))
+	flags.StringVar(&opts.schemaArtifact, "value-schema-artifact-id", "", opts.localizer.MustLocalize("kafka.topic.produce.flag.valueSchemaArtifactID.description"))
+	flags.StringVar(&opts.schemaGroup, "value-schema-group", util.DefaultArtifactGroup, opts.localizer.MustLocalize("kafka.topic.produce.flag.valueSchemaGroup.description"))
+	flags.StringVar(&opts.registryID, "registry-id", "", opts.localizer.MustLocalize("kafka.topic.common.flag.registryID.description"))
+	flags.BoolVar(&opts.schemaHeaders, "schema-id-headers", false, opts.localizer.MustLocalize("kafka.topic.produce.flag.schemaIDHeaders.description"))
+
+	_ = cmd.MarkFlagRequired("name")
+
+	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	flagutil.EnableStaticFlagCompletion(cmd, "format", messagecmdutil.ValidMessageFormats)
+
+	return cmd
+}
+
+// nolint:funlen
+func runCmd(opts *options) error {
+	headers, err := messagecmdutil.ParseHeaders(opts.headers)
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	topicNameTmplPair := localize.NewEntry("TopicName", opts.topicName)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	_, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.topicName).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "produce")
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.topicNotFoundError", topicNameTmplPair, kafkaNameTmplPair)
+		case http.StatusUnauthorized:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return err
+		}
+	}
+
+	// the configuration is loaded after the connection is created,
+	// so that it contains the refreshed access token
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	tokenSource, err := messagecmdutil.NewTokenSource(opts.Context, cfg, opts.credentialsFile)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+	}
+
+	var serializer *messagecmdutil.Serializer
+	if opts.schemaArtifact != "" {
+		if serializer, err = newSerializer(opts, conn, cfg); err != nil {
+			return err
+		}
+	}
+
+	bootstrapServer := kafkaInstance.GetBootstrapServerHost()
+	producer, err := sarama.NewSyncProducer([]string{bootstrapServer}, messagecmdutil.NewClientConfig(tokenSource))
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotConnect", localize.NewEntry("Name", kafkaInstance.GetName()), localize.NewEntry("ErrorMessage", err))
+	}
+	defer producer.Close()
+
+	var input io.Reader = opts.IO.In
+	if opts.file != "" {
+		// #nosec G304
+		file, err := os.Open(opts.file)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	} else if opts.IO.IsStdinTTY() {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.produce.log.info.readingFromStdin"))
+	}
+
+	inputOpts := &messagecmdutil.InputOptions{
+		Format:       opts.format,
+		KeySeparator: opts.keySeparator,
+		Headers:      headers,
+		Partition:    opts.partition,
+		Serializer:   serializer,
+	}
+
+	count, err := messagecmdutil.Produce(producer, opts.topicName, input, inputOpts)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.produce.error.produceFailed",
+			topicNameTmplPair,
+			localize.NewEntry("Count", count),
+			localize.NewEntry("ErrorMessage", err),
+		)
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalizePlural("kafka.topic.produce.log.info.messagesProduced", count,
+		localize.NewEntry("Count", count),
+		topicNameTmplPair,
+	))
+
+	return nil
+}
+
+// newSerializer fetches the value schema from Service Registry and creates a serializer for it
+func newSerializer(opts *options, conn connection.Connection, cfg *config.Config) (*messagecmdutil.Serializer, error) {
+	if opts.registryID == "" {
+		registryID, ok := cfg.GetServiceRegistryIdOk()
+		if !ok {
+			return nil, opts.localizer.MustLocalizeError("kafka.topic.common.error.noRegistrySelected")
+		}
+		opts.registryID = registryID
+	}
+
+	registryAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := messagecmdutil.NewRegistrySchemaResolver(opts.Context, registryAPI)
+	schema, err := resolver.ResolveArtifact(opts.schemaGroup, opts.schemaArtifact)
+	if err != nil {
+		return nil, opts.localizer.MustLocalizeError("kafka.topic.produce.error.couldNotFetchSchema",
+			localize.NewEntry("ArtifactID", opts.schemaArtifact),
+			localize.NewEntry("Group", opts.schemaGroup),
+			localize.NewEntry("ErrorMessage", err),
+		)
+	}
+
+	serializer, err := messagecmdutil.NewSerializer(schema, opts.schemaHeaders)
+	if err != nil {
+		return nil, opts.localizer.MustLocalizeError("kafka.topic.produce.error.invalidSchema",
+			localize.NewEntry("ArtifactID", opts.schemaArtifact),
+			localize.NewEntry("ErrorMessage", err),
+		)
+	}
+
+	opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.produce.log.debug.usingSchema",
+		localize.NewEntry("ArtifactID", opts.schemaArtifact),
+		localize.NewEntry("GlobalID", schema.GlobalID),
+	))
+
+	return serializer, nil
+}
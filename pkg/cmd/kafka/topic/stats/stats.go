@@ -0,0 +1,223 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Shopify/sarama"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messagecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+	"github.com/spf13/cobra"
+)
+
+// defaultMaxSkew is the percentage by which a partition can exceed the average before it is reported
+const defaultMaxSkew = 20
+
+type options struct {
+	name            string
+	kafkaID         string
+	outputFormat    string
+	maxSkew         float64
+	credentialsFile string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewStatsTopicCommand gets a new command for showing the partition statistics of a kafka topic.
+func NewStatsTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "stats",
+		Short:   opts.localizer.MustLocalize("kafka.topic.stats.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.stats.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.stats.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if opts.outputFormat != "" {
+				if err = flagutil.ValidateOutput(opts.outputFormat); err != nil {
+					return err
+				}
+			}
+
+			if opts.maxSkew < 0 {
+				return opts.localizer.MustLocalizeError("kafka.topic.stats.error.invalidMaxSkew", localize.NewEntry("MaxSkew", opts.maxSkew))
+			}
+
+			if opts.kafkaID != "" {
+				return runCmd(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetKafkaIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("kafka.topic.common.error.noKafkaSelected")
+			}
+
+			opts.kafkaID = instanceID
+
+			return runCmd(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+
+	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.name.description"))
+	flags.Float64Var(&opts.maxSkew, "max-skew", defaultMaxSkew, opts.localizer.MustLocalize("kafka.topic.stats.flag.maxSkew.description"))
+	flags.StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.credentialsFile.description"))
+	flags.AddOutput(&opts.outputFormat)
+
+	_ = cmd.MarkFlagRequired("name")
+
+	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+// nolint:funlen
+func runCmd(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	topic, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.name).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		topicNameTmplPair := localize.NewEntry("TopicName", opts.name)
+		kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+		operationTmplPair := localize.NewEntry("Operation", "describe")
+
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
+		case http.StatusUnauthorized:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return err
+		}
+	}
+
+	infos := topiccmdutil.NewPartitionInfos(topic.GetPartitions())
+
+	// the configuration is loaded after the connection is created,
+	// so that it contains the refreshed access token
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	tokenSource, err := messagecmdutil.NewTokenSource(opts.Context, cfg, opts.credentialsFile)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+	}
+
+	bootstrapServer := kafkaInstance.GetBootstrapServerHost()
+	client, err := sarama.NewClient([]string{bootstrapServer}, messagecmdutil.NewClientConfig(tokenSource))
+	if err != nil {
+		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.common.log.debug.partitionLogsNotAvailable", localize.NewEntry("ErrorMessage", err)))
+	} else {
+		defer client.Close()
+
+		offsetsErr, sizesErr := topiccmdutil.ReadLogs(topiccmdutil.NewLogReader(client), opts.name, infos)
+		for _, logErr := range []error{offsetsErr, sizesErr} {
+			if logErr != nil {
+				opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.common.log.debug.partitionLogsNotAvailable", localize.NewEntry("ErrorMessage", logErr)))
+			}
+		}
+	}
+
+	stats := topiccmdutil.ComputeTopicStats(opts.name, infos, opts.maxSkew)
+
+	if opts.outputFormat != "" {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, stats)
+	}
+
+	printStats(opts, stats)
+
+	return nil
+}
+
+// printStats prints the statistics, highlighting the partitions which need attention
+func printStats(opts *options, stats *topiccmdutil.TopicStats) {
+	out := opts.IO.Out
+
+	highlight := func(partitions []int32) string {
+		formatted := topiccmdutil.FormatPartitions(partitions)
+		if len(partitions) > 0 {
+			return color.Error(formatted)
+		}
+		return color.Success(formatted)
+	}
+
+	fmt.Fprintln(out, opts.localizer.MustLocalize("kafka.topic.stats.log.info.topic", localize.NewEntry("TopicName", stats.Topic)))
+	fmt.Fprintln(out, opts.localizer.MustLocalize("kafka.topic.stats.log.info.partitions", localize.NewEntry("Count", stats.Partitions)))
+	fmt.Fprintln(out, opts.localizer.MustLocalize("kafka.topic.stats.log.info.underReplicated", localize.NewEntry("Partitions", highlight(stats.UnderReplicated))))
+	fmt.Fprintln(out, opts.localizer.MustLocalize("kafka.topic.stats.log.info.offline", localize.NewEntry("Partitions", highlight(stats.Offline))))
+
+	if stats.Measure == "" {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, opts.localizer.MustLocalize("kafka.topic.stats.log.info.balanceNotAvailable"))
+		return
+	}
+
+	fmt.Fprintln(out, opts.localizer.MustLocalize("kafka.topic.stats.log.info.balance."+stats.Measure,
+		localize.NewEntry("Min", stats.Min),
+		localize.NewEntry("Max", stats.Max),
+		localize.NewEntry("Average", fmt.Sprintf("%.0f", stats.Average)),
+		localize.NewEntry("Total", stats.Total),
+	))
+	fmt.Fprintln(out, opts.localizer.MustLocalize("kafka.topic.stats.log.info.skew",
+		localize.NewEntry("Skew", fmt.Sprintf("%.1f", stats.Skew)),
+		localize.NewEntry("MaxSkew", opts.maxSkew),
+		localize.NewEntry("Partitions", highlight(stats.Skewed)),
+	))
+}
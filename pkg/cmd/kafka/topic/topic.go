@@ -1,10 +1,14 @@
 package topic
 
 import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/consume"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/copy"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/describe"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/produce"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/stats"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/update"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/spf13/cobra"
@@ -30,6 +34,10 @@ func NewTopicCommand(f *factory.Factory) *cobra.Command {
 		delete.NewDeleteTopicCommand(f),
 		describe.NewDescribeTopicCommand(f),
 		update.NewUpdateTopicCommand(f),
+		produce.NewProduceTopicCommand(f),
+		consume.NewConsumeTopicCommand(f),
+		stats.NewStatsTopicCommand(f),
+		copy.NewCopyTopicCommand(f),
 	)
 
 	return cmd
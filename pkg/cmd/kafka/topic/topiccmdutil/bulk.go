@@ -0,0 +1,130 @@
+package topiccmdutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/bulkutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"gopkg.in/yaml.v2"
+)
+
+// page size used when listing all topics of a Kafka instance
+const listPageSize = 100
+
+// TopicSpec describes a topic in a topics file
+type TopicSpec struct {
+	Name       string                 `json:"name" yaml:"name"`
+	Partitions int32                  `json:"partitions,omitempty" yaml:"partitions,omitempty"`
+	Config     map[string]interface{} `json:"config,omitempty" yaml:"config,omitempty"`
+}
+
+// topicsFile is the format of a topics file with a top-level "topics" key
+type topicsFile struct {
+	Topics []TopicSpec `json:"topics" yaml:"topics"`
+}
+
+// Configs returns the configurations of the topic as strings
+func (t *TopicSpec) Configs() (map[string]string, error) {
+	return toConfigValues(t.Config)
+}
+
+// ReadTopicsFile reads topic definitions from a YAML or JSON file.
+// The file contains either a list of topics, or an object with the list in the "topics" key
+func ReadTopicsFile(path string) ([]TopicSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file topicsFile
+	if err = yaml.Unmarshal(data, &file); err != nil {
+		if listErr := yaml.Unmarshal(data, &file.Topics); listErr != nil {
+			return nil, fmt.Errorf("invalid topics file %v: %w", path, err)
+		}
+	}
+
+	if len(file.Topics) == 0 {
+		return nil, fmt.Errorf("no topics are defined in file %v", path)
+	}
+
+	names := make(map[string]bool, len(file.Topics))
+	for i, topic := range file.Topics {
+		if topic.Name == "" {
+			return nil, fmt.Errorf("topic at position %v in file %v has no name", i+1, path)
+		}
+		if names[topic.Name] {
+			return nil, fmt.Errorf("topic %v is defined more than once in file %v", topic.Name, path)
+		}
+		names[topic.Name] = true
+	}
+
+	return file.Topics, nil
+}
+
+// MatchTopics returns the topics whose names fully match a regular expression, sorted by name
+func MatchTopics(topics []kafkainstanceclient.Topic, pattern string) ([]kafkainstanceclient.Topic, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid topic name pattern %q: %w", pattern, err)
+	}
+
+	var matched []kafkainstanceclient.Topic
+	for _, topic := range topics {
+		if re.MatchString(topic.GetName()) {
+			matched = append(matched, topic)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].GetName() < matched[j].GetName()
+	})
+
+	return matched, nil
+}
+
+// ListAllTopics fetches all topics of a Kafka instance, one page at a time.
+// The body of the returned response is already closed
+func ListAllTopics(ctx context.Context, api *kafkainstanceclient.APIClient) ([]kafkainstanceclient.Topic, *http.Response, error) {
+	var topics []kafkainstanceclient.Topic
+	for page := int32(1); ; page++ {
+		topicData, httpRes, err := api.TopicsApi.GetTopics(ctx).Page(page).Size(listPageSize).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, httpRes, err
+		}
+
+		items := topicData.GetItems()
+		topics = append(topics, items...)
+
+		if len(items) == 0 || len(topics) >= int(topicData.GetTotal()) {
+			return topics, httpRes, nil
+		}
+	}
+}
+
+// PrintBulkResults prints the summary table of a bulk operation.
+// An error is returned when the operation did not succeed for all topics
+func PrintBulkResults(out io.Writer, results []bulkutil.Result, localizer localize.Localizer, successStatus string) error {
+	dump.Table(out, bulkutil.MapResultsToTableRows(results, localizer, successStatus))
+
+	failed, skipped := bulkutil.CountFailures(results)
+	if failed == 0 && skipped == 0 {
+		return nil
+	}
+
+	return localizer.MustLocalizeError("kafka.topic.common.bulk.error.failed",
+		localize.NewEntry("Failed", failed),
+		localize.NewEntry("Total", len(results)),
+		localize.NewEntry("Skipped", skipped),
+	)
+}
@@ -0,0 +1,95 @@
+package topiccmdutil
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func TestReadTopicsFile(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"topics.yaml": `topics:
+  - name: orders
+    partitions: 3
+    config:
+      retention.ms: 86400000
+  - name: payments
+`,
+		"list.json":      `[{"name": "orders", "partitions": 3, "config": {"retention.ms": 86400000}}, {"name": "payments"}]`,
+		"duplicate.yaml": "- name: orders\n- name: orders\n",
+		"unnamed.yaml":   "- partitions: 2\n",
+		"empty.yaml":     "topics: []\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		wantErr bool
+	}{
+		{name: "Should read topics under the topics key", file: "topics.yaml"},
+		{name: "Should read a JSON list of topics", file: "list.json"},
+		{name: "Should fail for duplicate topics", file: "duplicate.yaml", wantErr: true},
+		{name: "Should fail for topics without a name", file: "unnamed.yaml", wantErr: true},
+		{name: "Should fail when no topics are defined", file: "empty.yaml", wantErr: true},
+	}
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadTopicsFile(filepath.Join(dir, tt.file))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadTopicsFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != 2 || got[0].Name != "orders" || got[0].Partitions != 3 || got[1].Name != "payments" {
+				t.Fatalf("ReadTopicsFile() = %v", got)
+			}
+
+			configs, err := got[0].Configs()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := map[string]string{RetentionMsKey: "86400000"}; !reflect.DeepEqual(configs, want) {
+				t.Errorf("Configs() = %v, want %v", configs, want)
+			}
+		})
+	}
+}
+
+func TestMatchTopics(t *testing.T) {
+	var topics []kafkainstanceclient.Topic
+	for _, name := range []string{"orders-eu", "payments", "orders-us", "old-orders-us"} {
+		topic := kafkainstanceclient.NewTopic()
+		topic.SetName(name)
+		topics = append(topics, *topic)
+	}
+
+	matched, err := MatchTopics(topics, "orders-.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, topic := range matched {
+		got = append(got, topic.GetName())
+	}
+	if want := []string{"orders-eu", "orders-us"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MatchTopics() = %v, want %v", got, want)
+	}
+
+	if _, err = MatchTopics(topics, "orders-("); err == nil {
+		t.Error("MatchTopics() expected error for invalid pattern")
+	}
+}
@@ -0,0 +1,337 @@
+package topiccmdutil
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigType is the type of the value of a topic configuration
+type ConfigType string
+
+// valid types of topic configuration values
+const (
+	BooleanConfig ConfigType = "boolean"
+	IntConfig     ConfigType = "int"
+	LongConfig    ConfigType = "long"
+	DoubleConfig  ConfigType = "double"
+	StringConfig  ConfigType = "string"
+	ListConfig    ConfigType = "list"
+)
+
+// ConfigDefinition describes a topic configuration supported by the Kafka instance
+type ConfigDefinition struct {
+	Type    ConfigType
+	Default string
+	// Min and Max are the inclusive bounds of numeric values, if any
+	Min *float64
+	Max *float64
+	// ValidValues lists the accepted values, or list items, if the value is an enumeration
+	ValidValues []string
+	// Editable is false when the managed Kafka service rejects changes to the value,
+	// such as "min.insync.replicas" and "compression.type"
+	Editable bool
+}
+
+func bound(v float64) *float64 {
+	return &v
+}
+
+var (
+	maxLong          = strconv.FormatInt(math.MaxInt64, 10)
+	compressionTypes = []string{"producer", "uncompressed", "gzip", "snappy", "lz4", "zstd"}
+	timestampTypes   = []string{"CreateTime", "LogAppendTime"}
+)
+
+// ConfigDefinitions contains the topic configurations which can be set when
+// creating or updating a topic, along with their default values on the managed service
+var ConfigDefinitions = map[string]ConfigDefinition{
+	CleanupPolicy:                             {Type: ListConfig, Default: "delete", ValidValues: []string{"delete", "compact"}, Editable: true},
+	RetentionMsKey:                            {Type: LongConfig, Default: "604800000", Min: bound(-1), Editable: true},
+	RetentionSizeKey:                          {Type: LongConfig, Default: "-1", Min: bound(-1), Editable: true},
+	"delete.retention.ms":                     {Type: LongConfig, Default: "86400000", Min: bound(0), Editable: true},
+	"max.compaction.lag.ms":                   {Type: LongConfig, Default: maxLong, Min: bound(1), Editable: true},
+	"max.message.bytes":                       {Type: IntConfig, Default: "1048588", Min: bound(0), Max: bound(1048588), Editable: true},
+	"message.timestamp.difference.max.ms":     {Type: LongConfig, Default: maxLong, Min: bound(0), Editable: true},
+	"message.timestamp.type":                  {Type: StringConfig, Default: "CreateTime", ValidValues: timestampTypes, Editable: true},
+	"min.cleanable.dirty.ratio":               {Type: DoubleConfig, Default: "0.5", Min: bound(0), Max: bound(1), Editable: true},
+	"min.compaction.lag.ms":                   {Type: LongConfig, Default: "0", Min: bound(0), Editable: true},
+	"segment.bytes":                           {Type: IntConfig, Default: "1073741824", Min: bound(52428800), Editable: true},
+	"segment.ms":                              {Type: LongConfig, Default: "604800000", Min: bound(600000), Editable: true},
+	"compression.type":                        {Type: StringConfig, Default: "producer", ValidValues: compressionTypes},
+	"file.delete.delay.ms":                    {Type: LongConfig, Default: "60000", Min: bound(0)},
+	"flush.messages":                          {Type: LongConfig, Default: maxLong, Min: bound(0)},
+	"flush.ms":                                {Type: LongConfig, Default: maxLong, Min: bound(0)},
+	"follower.replication.throttled.replicas": {Type: ListConfig, Default: ""},
+	"index.interval.bytes":                    {Type: IntConfig, Default: "4096", Min: bound(0)},
+	"leader.replication.throttled.replicas":   {Type: ListConfig, Default: ""},
+	"message.downconversion.enable":           {Type: BooleanConfig, Default: "true"},
+	"min.insync.replicas":                     {Type: IntConfig, Default: "2", Min: bound(1)},
+	"preallocate":                             {Type: BooleanConfig, Default: "false"},
+	"segment.index.bytes":                     {Type: IntConfig, Default: "10485760", Min: bound(4)},
+	"segment.jitter.ms":                       {Type: LongConfig, Default: "0", Min: bound(0)},
+	"unclean.leader.election.enable":          {Type: BooleanConfig, Default: "false"},
+}
+
+// EditableConfigKeys returns the sorted keys of the topic configurations which can be changed
+func EditableConfigKeys() []string {
+	var keys []string
+	for key, def := range ConfigDefinitions {
+		if def.Editable {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ParseConfigs parses a list of topic configurations in the "key=value" format
+func ParseConfigs(configs []string) (map[string]string, error) {
+	parsed := make(map[string]string, len(configs))
+	for _, config := range configs {
+		entry := strings.SplitN(config, "=", 2)
+		key := strings.TrimSpace(entry[0])
+		if len(entry) != 2 || key == "" {
+			return nil, fmt.Errorf(`invalid topic configuration "%v", configurations must be in the "key=value" format`, config)
+		}
+		parsed[key] = strings.TrimSpace(entry[1])
+	}
+
+	return parsed, nil
+}
+
+// LoadConfigs reads the topic configurations from a file, if set,
+// and overrides them with a list of configurations in the "key=value" format
+func LoadConfigs(entries []string, filePath string) (map[string]string, error) {
+	configs := map[string]string{}
+	if filePath != "" {
+		fileConfigs, err := ReadConfigFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		configs = fileConfigs
+	}
+
+	parsed, err := ParseConfigs(entries)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range parsed {
+		configs[key] = value
+	}
+
+	return configs, nil
+}
+
+// ReadConfigFile reads topic configurations from a file.
+// Files with the ".properties" extension are read as Java properties,
+// other files must contain a YAML or JSON object
+func ReadConfigFile(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if filepath.Ext(path) == ".properties" {
+		return parseProperties(data)
+	}
+
+	var values map[string]interface{}
+	if err = yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("invalid topic configuration file %v: %w", path, err)
+	}
+
+	return toConfigValues(values)
+}
+
+// toConfigValues converts configuration values parsed from YAML or JSON to strings.
+// Lists are converted to comma separated values
+func toConfigValues(values map[string]interface{}) (map[string]string, error) {
+	configs := make(map[string]string, len(values))
+	for key, value := range values {
+		switch v := value.(type) {
+		case nil:
+			configs[key] = ""
+		case []interface{}:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			configs[key] = strings.Join(items, ",")
+		case map[interface{}]interface{}, map[string]interface{}:
+			return nil, fmt.Errorf("invalid value for topic configuration %v", key)
+		default:
+			configs[key] = fmt.Sprint(v)
+		}
+	}
+
+	return configs, nil
+}
+
+func parseProperties(data []byte) (map[string]string, error) {
+	configs := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		entry, err := ParseConfigs([]string{line})
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range entry {
+			configs[k] = v
+		}
+	}
+
+	return configs, scanner.Err()
+}
+
+// ValidateConfigs validates topic configurations against their definitions
+func (v *Validator) ValidateConfigs(configs map[string]string) error {
+	keys := make([]string, 0, len(configs))
+	for key := range configs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := v.ValidateConfig(key, configs[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateConfig validates the value of a single topic configuration
+func (v *Validator) ValidateConfig(key string, value string) error {
+	def, ok := ConfigDefinitions[key]
+	if !ok {
+		return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.unknownKey", localize.NewEntry("Key", key), localize.NewEntry("ValidKeys", strings.Join(EditableConfigKeys(), ", ")))
+	}
+
+	if !def.Editable {
+		return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.notEditable", localize.NewEntry("Key", key), localize.NewEntry("ValidKeys", strings.Join(EditableConfigKeys(), ", ")))
+	}
+
+	keyTmplPair := localize.NewEntry("Key", key)
+	valueTmplPair := localize.NewEntry("Value", value)
+
+	var number float64
+	switch def.Type {
+	case BooleanConfig:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.invalidType", keyTmplPair, valueTmplPair, localize.NewEntry("Type", def.Type))
+		}
+	case IntConfig, LongConfig:
+		bitSize := 64
+		if def.Type == IntConfig {
+			bitSize = 32
+		}
+		n, err := strconv.ParseInt(value, 10, bitSize)
+		if err != nil {
+			return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.invalidType", keyTmplPair, valueTmplPair, localize.NewEntry("Type", def.Type))
+		}
+		number = float64(n)
+	case DoubleConfig:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.invalidType", keyTmplPair, valueTmplPair, localize.NewEntry("Type", def.Type))
+		}
+		number = n
+	case ListConfig:
+		for _, item := range strings.Split(value, ",") {
+			if err := v.validateValidValues(key, strings.TrimSpace(item), def); err != nil {
+				return err
+			}
+		}
+	default:
+		if err := v.validateValidValues(key, value, def); err != nil {
+			return err
+		}
+	}
+
+	if def.Min != nil && number < *def.Min {
+		return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.minValue", keyTmplPair, valueTmplPair, localize.NewEntry("Min", *def.Min))
+	}
+
+	if def.Max != nil && number > *def.Max {
+		return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.maxValue", keyTmplPair, valueTmplPair, localize.NewEntry("Max", *def.Max))
+	}
+
+	return nil
+}
+
+func (v *Validator) validateValidValues(key string, value string, def ConfigDefinition) error {
+	if len(def.ValidValues) == 0 {
+		return nil
+	}
+
+	for _, valid := range def.ValidValues {
+		if value == valid {
+			return nil
+		}
+	}
+
+	return v.Localizer.MustLocalizeError("kafka.topic.common.validation.config.error.invalidValue",
+		localize.NewEntry("Key", key),
+		localize.NewEntry("Value", value),
+		localize.NewEntry("ValidValues", strings.Join(def.ValidValues, ", ")),
+	)
+}
+
+// IsDefaultConfig checks if a config entry has the default value of the managed service
+func IsDefaultConfig(entry kafkainstanceclient.ConfigEntry) bool {
+	def, ok := ConfigDefinitions[entry.GetKey()]
+	if !ok {
+		return false
+	}
+
+	value := entry.GetValue()
+	if value == def.Default {
+		return true
+	}
+
+	// compare numbers by value, so that "1.0" is the same as "1"
+	switch def.Type {
+	case DoubleConfig:
+		n, err := strconv.ParseFloat(value, 64)
+		d, _ := strconv.ParseFloat(def.Default, 64)
+		return err == nil && n == d
+	case ListConfig:
+		items := strings.Split(value, ",")
+		for i := range items {
+			items[i] = strings.TrimSpace(items[i])
+		}
+		return strings.Join(items, ",") == def.Default
+	}
+
+	return false
+}
+
+// FilterDefaultConfigs removes the config entries which have the default value
+func FilterDefaultConfigs(entries []kafkainstanceclient.ConfigEntry) []kafkainstanceclient.ConfigEntry {
+	filtered := make([]kafkainstanceclient.ConfigEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !IsDefaultConfig(entry) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,124 @@
+package topiccmdutil
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{name: "Should be valid for an editable long value", key: "segment.ms", value: "3600000"},
+		{name: "Should be valid for a double within range", key: "min.cleanable.dirty.ratio", value: "0.25"},
+		{name: "Should be valid for a list of valid values", key: CleanupPolicy, value: "compact,delete"},
+		{name: "Should be valid for an enumeration value", key: "message.timestamp.type", value: "LogAppendTime"},
+		{name: "Should be invalid for an unknown key", key: "unknown.config", value: "1", wantErr: true},
+		{name: "Should be invalid for a key managed by the service", key: "min.insync.replicas", value: "1", wantErr: true},
+		{name: "Should be invalid when the value is not a number", key: "segment.ms", value: "1h", wantErr: true},
+		{name: "Should be invalid when an int value overflows", key: "max.message.bytes", value: "3000000000", wantErr: true},
+		{name: "Should be invalid when below the minimum value", key: RetentionMsKey, value: "-2", wantErr: true},
+		{name: "Should be invalid when above the maximum value", key: "max.message.bytes", value: "2097152", wantErr: true},
+		{name: "Should be invalid for an unknown list item", key: CleanupPolicy, value: "delete,archive", wantErr: true},
+		{name: "Should be invalid for an unknown enumeration value", key: "message.timestamp.type", value: "Now", wantErr: true},
+	}
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validator.ValidateConfig(tt.key, tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadConfigs(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlFile := filepath.Join(dir, "topic.yaml")
+	if err := ioutil.WriteFile(yamlFile, []byte("segment.ms: 3600000\ncleanup.policy:\n  - compact\n  - delete\nmin.cleanable.dirty.ratio: 0.1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	propertiesFile := filepath.Join(dir, "topic.properties")
+	if err := ioutil.WriteFile(propertiesFile, []byte("# topic configuration\nsegment.ms=3600000\nmax.message.bytes = 1024\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		entries  []string
+		filePath string
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			name:    "Should parse key value entries",
+			entries: []string{"segment.ms=3600000", "message.timestamp.type=LogAppendTime"},
+			want:    map[string]string{"segment.ms": "3600000", "message.timestamp.type": "LogAppendTime"},
+		},
+		{
+			name:     "Should read a YAML file",
+			filePath: yamlFile,
+			want:     map[string]string{"segment.ms": "3600000", "cleanup.policy": "compact,delete", "min.cleanable.dirty.ratio": "0.1"},
+		},
+		{
+			name:     "Should override file values with entries",
+			entries:  []string{"max.message.bytes=2048"},
+			filePath: propertiesFile,
+			want:     map[string]string{"segment.ms": "3600000", "max.message.bytes": "2048"},
+		},
+		{
+			name:    "Should fail for entries without a value",
+			entries: []string{"segment.ms"},
+			wantErr: true,
+		},
+		{
+			name:     "Should fail for a missing file",
+			filePath: filepath.Join(dir, "missing.yaml"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadConfigs(tt.entries, tt.filePath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfigs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadConfigs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterDefaultConfigs(t *testing.T) {
+	newEntry := func(key, value string) kafkainstanceclient.ConfigEntry {
+		entry := kafkainstanceclient.NewConfigEntry()
+		entry.SetKey(key)
+		entry.SetValue(value)
+		return *entry
+	}
+
+	entries := []kafkainstanceclient.ConfigEntry{
+		newEntry(RetentionMsKey, "604800000"),
+		newEntry(RetentionSizeKey, "1073741824"),
+		newEntry("min.cleanable.dirty.ratio", "0.50"),
+		newEntry(CleanupPolicy, "compact, delete"),
+		newEntry("message.format.version", "2.7-IV2"),
+	}
+
+	got := FilterDefaultConfigs(entries)
+	want := []kafkainstanceclient.ConfigEntry{entries[1], entries[3], entries[4]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterDefaultConfigs() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,219 @@
+package topiccmdutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+// CopyAction is the action taken to copy a topic to another Kafka instance
+type CopyAction string
+
+// actions taken to copy a topic
+const (
+	// CopyCreate creates the topic, which does not exist in the target instance
+	CopyCreate CopyAction = "create"
+	// CopyUpdate updates the existing topic in the target instance
+	CopyUpdate CopyAction = "update"
+	// CopySkip leaves the existing topic in the target instance unchanged
+	CopySkip CopyAction = "skip"
+	// CopyUnchanged is used when the existing topic already has the same definition
+	CopyUnchanged CopyAction = "unchanged"
+	// CopyConflict is used when the topic exists and conflicts are not allowed
+	CopyConflict CopyAction = "conflict"
+)
+
+// valid values for the handling of topics which already exist in the target instance
+const (
+	ConflictFail   = "fail"
+	ConflictSkip   = "skip"
+	ConflictUpdate = "update"
+)
+
+var ValidConflictStrategies = []string{ConflictFail, ConflictSkip, ConflictUpdate}
+
+// ConfigChange is a difference between the configuration of two topics
+type ConfigChange struct {
+	Key string
+	// From is the current value in the target topic, empty when the topic is created
+	From string
+	To   string
+}
+
+// CopyPlan describes how a topic is copied to the target Kafka instance
+type CopyPlan struct {
+	Source string
+	Target string
+	Action CopyAction
+	// Partitions is the number of partitions of the source topic
+	Partitions int32
+	// TargetPartitions is the number of partitions of the existing target topic
+	TargetPartitions int32
+	Configs          map[string]string
+	Changes          []ConfigChange
+}
+
+// CopiedConfigs returns the configurations of a topic which can be set in another Kafka instance.
+// Configurations which are managed by the service are not copied
+func CopiedConfigs(entries []kafkainstanceclient.ConfigEntry) map[string]string {
+	configs := map[string]string{}
+	for _, entry := range entries {
+		if def, ok := ConfigDefinitions[entry.GetKey()]; ok && def.Editable {
+			configs[entry.GetKey()] = entry.GetValue()
+		}
+	}
+
+	return configs
+}
+
+// PlanCopy compares the source topic with the existing topic in the target instance, if any,
+// and decides how the topic is copied, according to the conflict strategy
+func PlanCopy(source *kafkainstanceclient.Topic, targetName string, target *kafkainstanceclient.Topic, onConflict string) *CopyPlan {
+	plan := &CopyPlan{
+		Source:     source.GetName(),
+		Target:     targetName,
+		Partitions: int32(len(source.GetPartitions())),
+		Configs:    CopiedConfigs(source.GetConfig()),
+	}
+
+	if target == nil {
+		plan.Action = CopyCreate
+		for _, key := range sortedKeys(plan.Configs) {
+			def := ConfigDefinitions[key]
+			if !IsDefaultConfig(newConfigEntry(key, plan.Configs[key])) {
+				plan.Changes = append(plan.Changes, ConfigChange{Key: key, From: def.Default, To: plan.Configs[key]})
+			}
+		}
+		return plan
+	}
+
+	plan.TargetPartitions = int32(len(target.GetPartitions()))
+
+	targetConfigs := CopiedConfigs(target.GetConfig())
+	for _, key := range sortedKeys(plan.Configs) {
+		if value := plan.Configs[key]; value != targetConfigs[key] {
+			plan.Changes = append(plan.Changes, ConfigChange{Key: key, From: targetConfigs[key], To: value})
+		}
+	}
+
+	switch {
+	case len(plan.Changes) == 0 && plan.Partitions <= plan.TargetPartitions:
+		plan.Action = CopyUnchanged
+	case onConflict == ConflictSkip:
+		plan.Action = CopySkip
+	case onConflict == ConflictUpdate:
+		plan.Action = CopyUpdate
+	default:
+		plan.Action = CopyConflict
+	}
+
+	return plan
+}
+
+// NewTopicInput creates the request which creates the topic in the target instance
+func (p *CopyPlan) NewTopicInput() kafkainstanceclient.NewTopicInput {
+	return kafkainstanceclient.NewTopicInput{
+		Name: p.Target,
+		Settings: kafkainstanceclient.TopicSettings{
+			NumPartitions: p.Partitions,
+			Config:        CreateConfigEntries(toConfigPointers(p.Configs)),
+		},
+	}
+}
+
+// UpdateTopicInput creates the request which updates the existing topic in the target instance.
+// The number of partitions is only set when it increases, as it cannot be decreased
+func (p *CopyPlan) UpdateTopicInput() kafkainstanceclient.UpdateTopicInput {
+	input := kafkainstanceclient.UpdateTopicInput{}
+
+	if p.Partitions > p.TargetPartitions {
+		input.SetNumPartitions(p.Partitions)
+	}
+
+	if len(p.Changes) > 0 {
+		configs := make(map[string]string, len(p.Changes))
+		for _, change := range p.Changes {
+			configs[change.Key] = change.To
+		}
+		input.SetConfig(*CreateConfigEntries(toConfigPointers(configs)))
+	}
+
+	return input
+}
+
+// CopyPlanRow is the table representation of a copy plan
+type CopyPlanRow struct {
+	Source     string `json:"source" header:"Topic"`
+	Target     string `json:"target" header:"Target topic"`
+	Action     string `json:"action" header:"Action"`
+	Partitions string `json:"partitions" header:"Partitions"`
+	Changes    string `json:"changes" header:"Configuration changes"`
+}
+
+// MapCopyPlansToTableRows creates the table rows which show the differences
+// between the source topics and the topics in the target instance
+func MapCopyPlansToTableRows(plans []*CopyPlan) []CopyPlanRow {
+	rows := make([]CopyPlanRow, 0, len(plans))
+	for _, plan := range plans {
+		row := CopyPlanRow{
+			Source:     plan.Source,
+			Target:     plan.Target,
+			Action:     string(plan.Action),
+			Partitions: fmt.Sprint(plan.Partitions),
+			Changes:    "-",
+		}
+		// the number of partitions of an existing topic can only be increased
+		if plan.Action != CopyCreate {
+			row.Partitions = fmt.Sprint(plan.TargetPartitions)
+			if plan.Partitions > plan.TargetPartitions {
+				row.Partitions = fmt.Sprintf("%v -> %v", plan.TargetPartitions, plan.Partitions)
+			}
+		}
+
+		changes := make([]string, 0, len(plan.Changes))
+		for _, change := range plan.Changes {
+			if plan.Action == CopyCreate {
+				changes = append(changes, fmt.Sprintf("%v=%v", change.Key, change.To))
+			} else {
+				changes = append(changes, fmt.Sprintf("%v: %v -> %v", change.Key, change.From, change.To))
+			}
+		}
+		if len(changes) > 0 {
+			row.Changes = strings.Join(changes, ", ")
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func newConfigEntry(key string, value string) kafkainstanceclient.ConfigEntry {
+	entry := kafkainstanceclient.NewConfigEntry()
+	entry.SetKey(key)
+	entry.SetValue(value)
+
+	return *entry
+}
+
+func toConfigPointers(configs map[string]string) map[string]*string {
+	pointers := make(map[string]*string, len(configs))
+	for key := range configs {
+		value := configs[key]
+		pointers[key] = &value
+	}
+
+	return pointers
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
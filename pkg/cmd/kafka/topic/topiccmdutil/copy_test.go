@@ -0,0 +1,127 @@
+package topiccmdutil
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func newTopic(name string, partitions int, configs map[string]string) *kafkainstanceclient.Topic {
+	topic := kafkainstanceclient.NewTopic()
+	topic.SetName(name)
+
+	p := make([]kafkainstanceclient.Partition, partitions)
+	for i := range p {
+		p[i] = *kafkainstanceclient.NewPartition(int32(i))
+	}
+	topic.SetPartitions(p)
+
+	entries := make([]kafkainstanceclient.ConfigEntry, 0, len(configs))
+	for _, key := range sortedKeys(configs) {
+		entries = append(entries, newConfigEntry(key, configs[key]))
+	}
+	topic.SetConfig(entries)
+
+	return topic
+}
+
+func TestPlanCopy(t *testing.T) {
+	source := newTopic("orders", 3, map[string]string{
+		"retention.ms":        "86400000",
+		"cleanup.policy":      "delete",
+		"min.insync.replicas": "2",
+	})
+
+	tests := []struct {
+		name        string
+		target      *kafkainstanceclient.Topic
+		onConflict  string
+		wantAction  CopyAction
+		wantChanges []ConfigChange
+	}{
+		{
+			name:        "Should create a missing topic with the non-default configurations",
+			target:      nil,
+			onConflict:  ConflictFail,
+			wantAction:  CopyCreate,
+			wantChanges: []ConfigChange{{Key: "retention.ms", From: "604800000", To: "86400000"}},
+		},
+		{
+			name: "Should not change a topic with the same definition",
+			target: newTopic("orders", 3, map[string]string{
+				"retention.ms":        "86400000",
+				"cleanup.policy":      "delete",
+				"min.insync.replicas": "1",
+			}),
+			onConflict: ConflictFail,
+			wantAction: CopyUnchanged,
+		},
+		{
+			name:        "Should report a conflict by default",
+			target:      newTopic("orders", 3, map[string]string{"retention.ms": "604800000", "cleanup.policy": "delete"}),
+			onConflict:  ConflictFail,
+			wantAction:  CopyConflict,
+			wantChanges: []ConfigChange{{Key: "retention.ms", From: "604800000", To: "86400000"}},
+		},
+		{
+			name:        "Should skip a conflicting topic",
+			target:      newTopic("orders", 1, map[string]string{"retention.ms": "86400000", "cleanup.policy": "delete"}),
+			onConflict:  ConflictSkip,
+			wantAction:  CopySkip,
+			wantChanges: nil,
+		},
+		{
+			name:        "Should update a conflicting topic",
+			target:      newTopic("orders", 3, map[string]string{"retention.ms": "86400000", "cleanup.policy": "compact"}),
+			onConflict:  ConflictUpdate,
+			wantAction:  CopyUpdate,
+			wantChanges: []ConfigChange{{Key: "cleanup.policy", From: "compact", To: "delete"}},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			plan := PlanCopy(source, "orders", tt.target, tt.onConflict)
+
+			if plan.Action != tt.wantAction {
+				t.Errorf("PlanCopy() action = %v, want %v", plan.Action, tt.wantAction)
+			}
+			if !reflect.DeepEqual(plan.Changes, tt.wantChanges) {
+				t.Errorf("PlanCopy() changes = %+v, want %+v", plan.Changes, tt.wantChanges)
+			}
+			if _, ok := plan.Configs["min.insync.replicas"]; ok {
+				t.Errorf("PlanCopy() copies configuration managed by the service")
+			}
+		})
+	}
+}
+
+func TestCopyPlanUpdateTopicInput(t *testing.T) {
+	source := newTopic("orders", 3, map[string]string{"retention.ms": "86400000"})
+
+	t.Run("Should increase the number of partitions", func(t *testing.T) {
+		plan := PlanCopy(source, "orders", newTopic("orders", 1, map[string]string{"retention.ms": "86400000"}), ConflictUpdate)
+		input := plan.UpdateTopicInput()
+
+		if input.GetNumPartitions() != 3 {
+			t.Errorf("UpdateTopicInput() partitions = %v, want 3", input.GetNumPartitions())
+		}
+		if input.HasConfig() {
+			t.Errorf("UpdateTopicInput() config = %v, want none", input.GetConfig())
+		}
+	})
+
+	t.Run("Should not decrease the number of partitions", func(t *testing.T) {
+		plan := PlanCopy(source, "orders", newTopic("orders", 6, map[string]string{"retention.ms": "3600000"}), ConflictUpdate)
+		input := plan.UpdateTopicInput()
+
+		if input.HasNumPartitions() {
+			t.Errorf("UpdateTopicInput() partitions = %v, want none", input.GetNumPartitions())
+		}
+		if config := input.GetConfig(); len(config) != 1 || config[0].GetKey() != "retention.ms" || config[0].GetValue() != "86400000" {
+			t.Errorf("UpdateTopicInput() config = %+v", config)
+		}
+	})
+}
@@ -0,0 +1,358 @@
+package topiccmdutil
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+// PartitionInfo describes the replicas and the log of a topic partition.
+// The log offsets and size are only set when they could be read from the Kafka instance
+type PartitionInfo struct {
+	Partition      int32   `json:"partition" yaml:"partition"`
+	Leader         *int32  `json:"leader,omitempty" yaml:"leader,omitempty"`
+	Replicas       []int32 `json:"replicas" yaml:"replicas"`
+	ISR            []int32 `json:"isr" yaml:"isr"`
+	LogStartOffset *int64  `json:"log_start_offset,omitempty" yaml:"log_start_offset,omitempty"`
+	LogEndOffset   *int64  `json:"log_end_offset,omitempty" yaml:"log_end_offset,omitempty"`
+	Size           *int64  `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+// UnderReplicated is true when not all replicas of the partition are in sync
+func (p *PartitionInfo) UnderReplicated() bool {
+	return len(p.ISR) < len(p.Replicas)
+}
+
+// Messages returns the number of messages in the partition log, if the offsets are known
+func (p *PartitionInfo) Messages() (int64, bool) {
+	if p.LogStartOffset == nil || p.LogEndOffset == nil {
+		return 0, false
+	}
+
+	return *p.LogEndOffset - *p.LogStartOffset, true
+}
+
+// NewPartitionInfos converts the partitions returned by the Kafka instance API, sorted by partition number
+func NewPartitionInfos(partitions []kafkainstanceclient.Partition) []PartitionInfo {
+	infos := make([]PartitionInfo, 0, len(partitions))
+	for _, p := range partitions {
+		info := PartitionInfo{
+			Partition: p.GetPartition(),
+			Replicas:  nodeIDs(p.GetReplicas()),
+			ISR:       nodeIDs(p.GetIsr()),
+		}
+		if leader, ok := p.GetLeaderOk(); ok && leader != nil {
+			if id, ok := nodeID(*leader); ok {
+				info.Leader = &id
+			}
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Partition < infos[j].Partition
+	})
+
+	return infos
+}
+
+func nodeIDs(nodes []map[string]interface{}) []int32 {
+	ids := make([]int32, 0, len(nodes))
+	for _, node := range nodes {
+		if id, ok := nodeID(node); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// nodeID reads the ID of a broker node, which is decoded from JSON as a number
+func nodeID(node map[string]interface{}) (int32, bool) {
+	switch id := node["id"].(type) {
+	case float64:
+		return int32(id), true
+	case int32:
+		return id, true
+	case int:
+		return int32(id), true
+	default:
+		return 0, false
+	}
+}
+
+// LogReader reads the offsets and sizes of partition logs from the Kafka instance
+type LogReader interface {
+	// Offsets returns the first and the next offset of the partition log
+	Offsets(topic string, partition int32) (start int64, end int64, err error)
+	// Sizes returns the size in bytes of the partition logs on the brokers, by partition
+	Sizes(topic string, brokers []int32) (map[int32]int64, error)
+}
+
+// saramaLogReader reads partition logs using the Kafka protocol
+type saramaLogReader struct {
+	client sarama.Client
+}
+
+// NewLogReader creates a log reader for a Kafka client
+func NewLogReader(client sarama.Client) LogReader {
+	return &saramaLogReader{client: client}
+}
+
+func (r *saramaLogReader) Offsets(topic string, partition int32) (int64, int64, error) {
+	start, err := r.client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err := r.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return start, end, nil
+}
+
+func (r *saramaLogReader) Sizes(topic string, brokers []int32) (map[int32]int64, error) {
+	// the cluster admin is not closed, as it would close the client
+	admin, err := sarama.NewClusterAdminFromClient(r.client)
+	if err != nil {
+		return nil, err
+	}
+
+	logDirs, err := admin.DescribeLogDirs(brokers)
+	if err != nil {
+		return nil, err
+	}
+
+	return largestReplicaSizes(topic, logDirs)
+}
+
+// largestReplicaSizes returns the size of the largest replica of each partition of the topic
+func largestReplicaSizes(topic string, logDirs map[int32][]sarama.DescribeLogDirsResponseDirMetadata) (map[int32]int64, error) {
+	sizes := map[int32]int64{}
+	for _, dirs := range logDirs {
+		for _, dir := range dirs {
+			if dir.ErrorCode != sarama.ErrNoError {
+				return nil, dir.ErrorCode
+			}
+			for _, t := range dir.Topics {
+				if t.Topic != topic {
+					continue
+				}
+				for _, p := range t.Partitions {
+					if cur, ok := sizes[p.PartitionID]; !ok || p.Size > cur {
+						sizes[p.PartitionID] = p.Size
+					}
+				}
+			}
+		}
+	}
+
+	return sizes, nil
+}
+
+// ReadLogs sets the log offsets and sizes of the partitions.
+// The offsets and sizes are read independently, so that the offsets are set
+// even when the sizes are not available, as reading them requires more permissions
+func ReadLogs(reader LogReader, topic string, infos []PartitionInfo) (offsetsErr error, sizesErr error) {
+	brokerSet := map[int32]bool{}
+	for i := range infos {
+		for _, id := range infos[i].Replicas {
+			brokerSet[id] = true
+		}
+
+		if offsetsErr != nil {
+			continue
+		}
+		start, end, err := reader.Offsets(topic, infos[i].Partition)
+		if err != nil {
+			offsetsErr = err
+			continue
+		}
+		infos[i].LogStartOffset = &start
+		infos[i].LogEndOffset = &end
+	}
+
+	brokers := make([]int32, 0, len(brokerSet))
+	for id := range brokerSet {
+		brokers = append(brokers, id)
+	}
+	sort.Slice(brokers, func(i, j int) bool { return brokers[i] < brokers[j] })
+
+	sizes, err := reader.Sizes(topic, brokers)
+	if err != nil {
+		return offsetsErr, err
+	}
+	for i := range infos {
+		if size, ok := sizes[infos[i].Partition]; ok {
+			infos[i].Size = &size
+		}
+	}
+
+	return offsetsErr, nil
+}
+
+// PartitionRow is the table representation of a partition
+type PartitionRow struct {
+	Partition       int32  `header:"Partition"`
+	Leader          string `header:"Leader"`
+	Replicas        string `header:"Replicas"`
+	ISR             string `header:"In-sync replicas"`
+	LogEndOffset    string `header:"Log end offset"`
+	Size            string `header:"Size (bytes)"`
+	UnderReplicated bool   `header:"Under-replicated"`
+}
+
+// MapPartitionsToTableRows creates the table rows of the partitions
+func MapPartitionsToTableRows(infos []PartitionInfo) []PartitionRow {
+	rows := make([]PartitionRow, 0, len(infos))
+	for i := range infos {
+		info := &infos[i]
+		row := PartitionRow{
+			Partition:       info.Partition,
+			Leader:          "-",
+			Replicas:        joinIDs(info.Replicas, ","),
+			ISR:             joinIDs(info.ISR, ","),
+			LogEndOffset:    "-",
+			Size:            "-",
+			UnderReplicated: info.UnderReplicated(),
+		}
+		if info.Leader != nil {
+			row.Leader = strconv.Itoa(int(*info.Leader))
+		}
+		if info.LogEndOffset != nil {
+			row.LogEndOffset = strconv.FormatInt(*info.LogEndOffset, 10)
+		}
+		if info.Size != nil {
+			row.Size = strconv.FormatInt(*info.Size, 10)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func joinIDs(ids []int32, sep string) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.Itoa(int(id)))
+	}
+
+	return strings.Join(values, sep)
+}
+
+// valid measures of the partition balance
+const (
+	SizeMeasure     = "size"
+	MessagesMeasure = "messages"
+)
+
+// TopicStats summarizes the health of the partitions of a topic
+type TopicStats struct {
+	Topic           string  `json:"topic" yaml:"topic"`
+	Partitions      int     `json:"partitions" yaml:"partitions"`
+	UnderReplicated []int32 `json:"under_replicated_partitions" yaml:"under_replicated_partitions"`
+	Offline         []int32 `json:"offline_partitions" yaml:"offline_partitions"`
+	// Measure is the value used to compare partitions, either their size or their number of messages.
+	// It is empty when neither is known
+	Measure string  `json:"measure,omitempty" yaml:"measure,omitempty"`
+	Total   int64   `json:"total" yaml:"total"`
+	Min     int64   `json:"min" yaml:"min"`
+	Max     int64   `json:"max" yaml:"max"`
+	Average float64 `json:"average" yaml:"average"`
+	// Skew is how much the largest partition exceeds the average, in percent
+	Skew float64 `json:"skew_percent" yaml:"skew_percent"`
+	// Skewed lists the partitions which exceed the average by more than the maximum skew
+	Skewed []int32 `json:"skewed_partitions" yaml:"skewed_partitions"`
+}
+
+// ComputeTopicStats finds the under-replicated and offline partitions of a topic,
+// and the partitions which exceed the average size by more than maxSkew percent.
+// The number of messages is compared when the sizes of the partitions are not known
+func ComputeTopicStats(topic string, infos []PartitionInfo, maxSkew float64) *TopicStats {
+	stats := &TopicStats{
+		Topic:           topic,
+		Partitions:      len(infos),
+		UnderReplicated: []int32{},
+		Offline:         []int32{},
+		Skewed:          []int32{},
+	}
+
+	for i := range infos {
+		if infos[i].UnderReplicated() {
+			stats.UnderReplicated = append(stats.UnderReplicated, infos[i].Partition)
+		}
+		if infos[i].Leader == nil || *infos[i].Leader < 0 {
+			stats.Offline = append(stats.Offline, infos[i].Partition)
+		}
+	}
+
+	values, measure := partitionMeasures(infos)
+	if len(values) == 0 {
+		return stats
+	}
+	stats.Measure = measure
+
+	stats.Min = math.MaxInt64
+	for _, v := range values {
+		stats.Total += v
+		if v < stats.Min {
+			stats.Min = v
+		}
+		if v > stats.Max {
+			stats.Max = v
+		}
+	}
+	stats.Average = float64(stats.Total) / float64(len(values))
+
+	if stats.Average == 0 {
+		return stats
+	}
+
+	stats.Skew = (float64(stats.Max) - stats.Average) / stats.Average * 100
+	for i := range infos {
+		if float64(values[infos[i].Partition])-stats.Average > stats.Average*maxSkew/100 {
+			stats.Skewed = append(stats.Skewed, infos[i].Partition)
+		}
+	}
+
+	return stats
+}
+
+// partitionMeasures returns the sizes of the partitions if they are all known,
+// otherwise the number of messages if they are all known
+func partitionMeasures(infos []PartitionInfo) (map[int32]int64, string) {
+	sizes := make(map[int32]int64, len(infos))
+	messages := make(map[int32]int64, len(infos))
+	for i := range infos {
+		if infos[i].Size != nil {
+			sizes[infos[i].Partition] = *infos[i].Size
+		}
+		if n, ok := infos[i].Messages(); ok {
+			messages[infos[i].Partition] = n
+		}
+	}
+
+	switch {
+	case len(infos) > 0 && len(sizes) == len(infos):
+		return sizes, SizeMeasure
+	case len(infos) > 0 && len(messages) == len(infos):
+		return messages, MessagesMeasure
+	default:
+		return nil, ""
+	}
+}
+
+// FormatPartitions formats a list of partition numbers
+func FormatPartitions(partitions []int32) string {
+	if len(partitions) == 0 {
+		return "-"
+	}
+
+	return joinIDs(partitions, ", ")
+}
@@ -0,0 +1,172 @@
+package topiccmdutil
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+// fakeLogReader returns partition logs from memory
+type fakeLogReader struct {
+	offsets  map[int32][2]int64
+	sizes    map[int32]int64
+	sizesErr error
+}
+
+func (r *fakeLogReader) Offsets(topic string, partition int32) (int64, int64, error) {
+	offsets, ok := r.offsets[partition]
+	if !ok {
+		return 0, 0, errors.New("unknown partition")
+	}
+	return offsets[0], offsets[1], nil
+}
+
+func (r *fakeLogReader) Sizes(topic string, brokers []int32) (map[int32]int64, error) {
+	return r.sizes, r.sizesErr
+}
+
+func newPartition(partition int32, leader int, replicas []int, isr []int) kafkainstanceclient.Partition {
+	nodes := func(ids []int) []map[string]interface{} {
+		var n []map[string]interface{}
+		for _, id := range ids {
+			// node IDs are decoded from JSON as numbers
+			n = append(n, map[string]interface{}{"id": float64(id)})
+		}
+		return n
+	}
+
+	p := kafkainstanceclient.NewPartition(partition)
+	p.SetReplicas(nodes(replicas))
+	p.SetIsr(nodes(isr))
+	if leader >= 0 {
+		p.SetLeader(map[string]interface{}{"id": float64(leader)})
+	}
+	return *p
+}
+
+func TestNewPartitionInfos(t *testing.T) {
+	infos := NewPartitionInfos([]kafkainstanceclient.Partition{
+		newPartition(1, 2, []int{2, 0, 1}, []int{2, 0}),
+		newPartition(0, 0, []int{0, 1, 2}, []int{0, 1, 2}),
+	})
+
+	if len(infos) != 2 || infos[0].Partition != 0 || infos[1].Partition != 1 {
+		t.Fatalf("NewPartitionInfos() = %+v, want partitions sorted by number", infos)
+	}
+	if *infos[1].Leader != 2 || !reflect.DeepEqual(infos[1].Replicas, []int32{2, 0, 1}) || !reflect.DeepEqual(infos[1].ISR, []int32{2, 0}) {
+		t.Errorf("NewPartitionInfos() partition 1 = %+v", infos[1])
+	}
+	if infos[0].UnderReplicated() || !infos[1].UnderReplicated() {
+		t.Errorf("UnderReplicated() is wrong for partitions %+v", infos)
+	}
+}
+
+func TestReadLogs(t *testing.T) {
+	reader := &fakeLogReader{
+		offsets:  map[int32][2]int64{0: {0, 100}, 1: {10, 50}},
+		sizesErr: errors.New("cluster authorization failed"),
+	}
+	infos := NewPartitionInfos([]kafkainstanceclient.Partition{
+		newPartition(0, 0, []int{0, 1}, []int{0, 1}),
+		newPartition(1, 1, []int{1, 0}, []int{1, 0}),
+	})
+
+	offsetsErr, sizesErr := ReadLogs(reader, "orders", infos)
+	if offsetsErr != nil || sizesErr == nil {
+		t.Fatalf("ReadLogs() = %v, %v, want only an error for the sizes", offsetsErr, sizesErr)
+	}
+
+	if n, ok := infos[1].Messages(); !ok || n != 40 {
+		t.Errorf("Messages() = %v, %v, want 40", n, ok)
+	}
+	if infos[0].Size != nil {
+		t.Errorf("Size = %v, want no size", *infos[0].Size)
+	}
+}
+
+func TestLargestReplicaSizes(t *testing.T) {
+	replicas := func(sizes map[int32]int64) []sarama.DescribeLogDirsResponseDirMetadata {
+		var partitions []sarama.DescribeLogDirsResponsePartition
+		for id, size := range sizes {
+			partitions = append(partitions, sarama.DescribeLogDirsResponsePartition{PartitionID: id, Size: size})
+		}
+		return []sarama.DescribeLogDirsResponseDirMetadata{{
+			ErrorCode: sarama.ErrNoError,
+			Topics: []sarama.DescribeLogDirsResponseTopic{
+				{Topic: "orders", Partitions: partitions},
+				{Topic: "payments", Partitions: []sarama.DescribeLogDirsResponsePartition{{PartitionID: 2, Size: 900}}},
+			},
+		}}
+	}
+	logDirs := map[int32][]sarama.DescribeLogDirsResponseDirMetadata{
+		0: replicas(map[int32]int64{0: 100, 1: 0}),
+		1: replicas(map[int32]int64{0: 120, 1: 0}),
+	}
+
+	sizes, err := largestReplicaSizes("orders", logDirs)
+	if err != nil {
+		t.Fatalf("largestReplicaSizes() error = %v", err)
+	}
+
+	want := map[int32]int64{0: 120, 1: 0}
+	if !reflect.DeepEqual(sizes, want) {
+		t.Errorf("largestReplicaSizes() = %v, want %v", sizes, want)
+	}
+}
+
+func TestComputeTopicStats(t *testing.T) {
+	partitions := []kafkainstanceclient.Partition{
+		newPartition(0, 0, []int{0, 1, 2}, []int{0, 1, 2}),
+		newPartition(1, 1, []int{1, 2, 0}, []int{1, 2}),
+		newPartition(2, -1, []int{2, 0, 1}, []int{}),
+		newPartition(3, 0, []int{0, 1, 2}, []int{0, 1, 2}),
+	}
+
+	t.Run("Should compare the sizes of the partitions", func(t *testing.T) {
+		infos := NewPartitionInfos(partitions)
+		reader := &fakeLogReader{
+			offsets: map[int32][2]int64{0: {0, 10}, 1: {0, 10}, 2: {0, 10}, 3: {0, 10}},
+			sizes:   map[int32]int64{0: 100, 1: 100, 2: 100, 3: 500},
+		}
+		_, _ = ReadLogs(reader, "orders", infos)
+
+		stats := ComputeTopicStats("orders", infos, 20)
+
+		if !reflect.DeepEqual(stats.UnderReplicated, []int32{1, 2}) {
+			t.Errorf("UnderReplicated = %v, want [1 2]", stats.UnderReplicated)
+		}
+		if !reflect.DeepEqual(stats.Offline, []int32{2}) {
+			t.Errorf("Offline = %v, want [2]", stats.Offline)
+		}
+		if stats.Measure != SizeMeasure || stats.Total != 800 || stats.Min != 100 || stats.Max != 500 || stats.Average != 200 {
+			t.Errorf("ComputeTopicStats() = %+v", stats)
+		}
+		if stats.Skew != 150 || !reflect.DeepEqual(stats.Skewed, []int32{3}) {
+			t.Errorf("Skew = %v, Skewed = %v, want 150 and [3]", stats.Skew, stats.Skewed)
+		}
+	})
+
+	t.Run("Should compare the number of messages without sizes", func(t *testing.T) {
+		infos := NewPartitionInfos(partitions)
+		reader := &fakeLogReader{
+			offsets:  map[int32][2]int64{0: {0, 10}, 1: {5, 15}, 2: {0, 10}, 3: {0, 10}},
+			sizesErr: errors.New("cluster authorization failed"),
+		}
+		_, _ = ReadLogs(reader, "orders", infos)
+
+		stats := ComputeTopicStats("orders", infos, 20)
+		if stats.Measure != MessagesMeasure || stats.Skew != 0 || len(stats.Skewed) != 0 {
+			t.Errorf("ComputeTopicStats() = %+v", stats)
+		}
+	})
+
+	t.Run("Should not compare partitions without logs", func(t *testing.T) {
+		stats := ComputeTopicStats("orders", NewPartitionInfos(partitions), 20)
+		if stats.Measure != "" {
+			t.Errorf("Measure = %v, want none", stats.Measure)
+		}
+	})
+}
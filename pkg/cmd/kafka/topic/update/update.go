@@ -9,6 +9,7 @@ import (
 	kafkacmdutil "github.com/redhat-developer/app-services-cli/pkg/kafkautil"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/bulkutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
@@ -17,6 +18,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 
 	"github.com/spf13/cobra"
 )
@@ -29,12 +31,20 @@ var (
 
 type options struct {
 	name              string
+	pattern           string
+	force             bool
+	continueOnError   bool
 	partitionsStr     string
 	retentionMsStr    string
 	retentionBytesStr string
 	kafkaID           string
 	interactive       bool
 	cleanupPolicy     string
+	config            []string
+	configFile        string
+
+	// configs contains the topic configurations set with the --config and --config-file flags
+	configs map[string]string
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -67,22 +77,55 @@ func NewUpdateTopicCommand(f *factory.Factory) *cobra.Command {
 				Localizer: opts.localizer,
 			}
 
-			if !opts.IO.CanPrompt() && opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" {
+			if opts.name != "" && opts.pattern != "" {
+				return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive", localize.NewEntry("Flag1", "name"), localize.NewEntry("Flag2", "pattern"))
+			}
+
+			if opts.name == "" && opts.pattern == "" {
+				return opts.localizer.MustLocalizeError("flag.error.requiredOneOf", localize.NewEntry("Flag1", "name"), localize.NewEntry("Flag2", "pattern"))
+			}
+
+			opts.configs, err = topiccmdutil.LoadConfigs(opts.config, opts.configFile)
+			if err != nil {
+				return err
+			}
+
+			noConfigs := len(opts.configs) == 0 && opts.configFile == ""
+
+			if opts.pattern != "" {
+				if !opts.IO.CanPrompt() && !opts.force {
+					return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "yes"))
+				}
+
+				// bulk updates are not run interactively
+				if opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" && opts.cleanupPolicy == "" && len(opts.configs) == 0 {
+					opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.nothingToUpdate"))
+					return nil
+				}
+			}
+
+			if !opts.IO.CanPrompt() && opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" && noConfigs {
 				return opts.localizer.MustLocalizeError("argument.error.requiredWhenNonInteractive", localize.NewEntry("Argument", "name"))
-			} else if opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" && opts.cleanupPolicy == "" {
+			} else if opts.pattern == "" && opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" && opts.cleanupPolicy == "" && noConfigs {
 				opts.interactive = true
 			}
 
 			if !opts.interactive {
-				if opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" && opts.cleanupPolicy == "" {
+				if opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" && opts.cleanupPolicy == "" && len(opts.configs) == 0 {
 					opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.nothingToUpdate"))
 					return nil
 				}
 
-				if err = validator.ValidateName(opts.name); err != nil {
+				if err = validator.ValidateConfigs(opts.configs); err != nil {
 					return err
 				}
 
+				if opts.pattern == "" {
+					if err = validator.ValidateName(opts.name); err != nil {
+						return err
+					}
+				}
+
 				// check that a valid --cleanup-policy flag value is used
 				if opts.cleanupPolicy != "" {
 					validPolicy := flagutil.IsValidInput(opts.cleanupPolicy, topiccmdutil.ValidCleanupPolicies...)
@@ -150,12 +193,17 @@ func NewUpdateTopicCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.retentionBytesStr, "retention-bytes", "", opts.localizer.MustLocalize("kafka.topic.common.input.retentionBytes.description"))
 	flags.StringVar(&opts.cleanupPolicy, "cleanup-policy", "", opts.localizer.MustLocalize("kafka.topic.common.input.cleanupPolicy.description"))
 	flags.StringVar(&opts.partitionsStr, "partitions", "", opts.localizer.MustLocalize("kafka.topic.common.input.partitions.description"))
+	flags.StringArrayVar(&opts.config, "config", []string{}, opts.localizer.MustLocalize("kafka.topic.common.flag.config.description"))
+	flags.StringVar(&opts.configFile, "config-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.configFile.description"))
 
 	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.name.description"))
 	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
 	})
-	_ = cmd.MarkFlagRequired("name")
+
+	flags.StringVar(&opts.pattern, "pattern", "", opts.localizer.MustLocalize("kafka.topic.common.flag.pattern.description"))
+	flags.BoolVar(&opts.continueOnError, "continue-on-error", false, opts.localizer.MustLocalize("kafka.topic.common.flag.continueOnError.description"))
+	flags.AddYes(&opts.force)
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -206,8 +254,9 @@ func runCmd(opts *options) error {
 		return err
 	}
 
-	// track if any values have changed
-	var needsUpdate bool
+	if opts.pattern != "" {
+		return runBulkUpdate(opts, api, kafkaInstance)
+	}
 
 	topic, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.name).Execute()
 	if httpRes != nil {
@@ -225,13 +274,102 @@ func runCmd(opts *options) error {
 		}
 	}
 
+	topicSettings, needsUpdate := newUpdateTopicInput(opts, &topic)
+	if !needsUpdate {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.nothingToUpdate"))
+		return nil
+	}
+
+	if err = updateTopic(opts, api, kafkaInstance, opts.name, topicSettings); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.topicUpdated", topicNameTmplPair, kafkaNameTmplPair))
+	return nil
+}
+
+// runBulkUpdate updates all topics with names matching the pattern
+func runBulkUpdate(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	topics, _, err := topiccmdutil.ListAllTopics(opts.Context, api)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.listTopics", kafkaNameTmplPair, localize.NewEntry("ErrorMessage", err))
+	}
+
+	matched, err := topiccmdutil.MatchTopics(topics, opts.pattern)
+	if err != nil {
+		return err
+	}
+
+	if len(matched) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.common.bulk.log.info.noTopicsMatch", kafkaNameTmplPair, localize.NewEntry("Pattern", opts.pattern)))
+		return nil
+	}
+
+	// only the topics which have different values are updated
+	updates := make(map[string]*kafkainstanceclient.UpdateTopicInput, len(matched))
+	names := make([]string, 0, len(matched))
+	for i := range matched {
+		name := matched[i].GetName()
+		topicSettings, needsUpdate := newUpdateTopicInput(opts, &matched[i])
+		if !needsUpdate {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.update.log.debug.topicUpToDate", localize.NewEntry("TopicName", name)))
+			continue
+		}
+		updates[name] = topicSettings
+		names = append(names, name)
+	}
+
+	if len(names) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.nothingToUpdate"))
+		return nil
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.topic.update.log.info.topicsPreview", len(names), kafkaNameTmplPair, localize.NewEntry("Count", len(names))))
+	opts.Logger.Info()
+	for _, name := range names {
+		opts.Logger.Info("  " + name)
+	}
+	opts.Logger.Info()
+
+	if !opts.force {
+		confirmed, err := bulkutil.Confirm(opts.localizer)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			opts.Logger.Debug(opts.localizer.MustLocalize("kafka.topic.common.bulk.log.debug.notConfirmed"))
+			return nil
+		}
+	}
+
+	results := bulkutil.Run(names, opts.continueOnError, func(name string) error {
+		return updateTopic(opts, api, kafkaInstance, name, updates[name])
+	})
+
+	return topiccmdutil.PrintBulkResults(opts.IO.Out, results, opts.localizer, opts.localizer.MustLocalize("kafka.topic.update.bulk.status.updated"))
+}
+
+// newUpdateTopicInput creates the update request for the topic,
+// and reports whether any of the values differ from the current ones
+func newUpdateTopicInput(opts *options, topic *kafkainstanceclient.Topic) (*kafkainstanceclient.UpdateTopicInput, bool) {
+	// track if any values have changed
+	var needsUpdate bool
+
 	// map to store the config entries which will be updated
 	configEntryMap := map[string]*string{}
 
-	updateTopicReq := api.TopicsApi.UpdateTopic(opts.Context, opts.name)
-
 	topicSettings := &kafkainstanceclient.UpdateTopicInput{}
 
+	for key := range opts.configs {
+		value := opts.configs[key]
+		if value != topiccmdutil.GetConfigValue(topic.GetConfig(), key) {
+			needsUpdate = true
+			configEntryMap[key] = &value
+		}
+	}
+
 	if opts.retentionMsStr != "" {
 		needsUpdate = true
 		configEntryMap[topiccmdutil.RetentionMsKey] = &opts.retentionMsStr
@@ -252,49 +390,45 @@ func runCmd(opts *options) error {
 		topicSettings.SetNumPartitions(partitionCount)
 	}
 
-	if !needsUpdate {
-		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.nothingToUpdate"))
-		return nil
-	}
-
 	if len(configEntryMap) > 0 {
 		configEntries := topiccmdutil.CreateConfigEntries(configEntryMap)
 		topicSettings.SetConfig(*configEntries)
 	}
 
-	updateTopicReq = updateTopicReq.UpdateTopicInput(*topicSettings)
+	return topicSettings, needsUpdate
+}
 
-	// update the topic
-	_, httpRes, err = updateTopicReq.Execute()
+// updateTopic performs the update topic API request
+func updateTopic(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest, name string, topicSettings *kafkainstanceclient.UpdateTopicInput) error {
+	_, httpRes, err := api.TopicsApi.UpdateTopic(opts.Context, name).UpdateTopicInput(*topicSettings).Execute()
 	if httpRes != nil {
 		defer httpRes.Body.Close()
 	}
+	if err == nil {
+		return nil
+	}
 
-	// handle error
-	if err != nil {
-		if httpRes == nil {
-			return err
-		}
-
-		operationTmplPair := localize.NewEntry("Operation", "update")
-		switch httpRes.StatusCode {
-		case http.StatusNotFound:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
-		case http.StatusUnauthorized:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
-		case http.StatusForbidden:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
-		case http.StatusInternalServerError:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
-		case http.StatusServiceUnavailable:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
-		default:
-			return err
-		}
+	if httpRes == nil {
+		return err
 	}
 
-	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.topicUpdated", topicNameTmplPair, kafkaNameTmplPair))
-	return nil
+	topicNameTmplPair := localize.NewEntry("TopicName", name)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+	operationTmplPair := localize.NewEntry("Operation", "update")
+	switch httpRes.StatusCode {
+	case http.StatusNotFound:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
+	case http.StatusUnauthorized:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+	case http.StatusForbidden:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+	case http.StatusInternalServerError:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+	case http.StatusServiceUnavailable:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+	default:
+		return err
+	}
 }
 
 func runInteractivePrompt(opts *options) (err error) {
@@ -1,6 +1,7 @@
 package artifact
 
 import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/check"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/crud/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/crud/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/crud/get"
@@ -10,6 +11,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/metadata"
 	migrate "github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/migrate"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/state"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/sync"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/versions"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/spf13/cobra"
@@ -41,6 +43,8 @@ func NewArtifactsCommand(f *factory.Factory) *cobra.Command {
 		migrate.NewExportCommand(f),
 		migrate.NewImportCommand(f),
 		state.NewSetStateCommand(f),
+		check.NewCheckCommand(f),
+		sync.NewSyncCommand(f),
 	)
 
 	return cmd
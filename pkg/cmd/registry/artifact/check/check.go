@@ -0,0 +1,362 @@
+package check
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/compatcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/rulecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+	"github.com/spf13/cobra"
+)
+
+// versionsPageSize is the number of versions listed in each request when all the versions are checked
+const versionsPageSize = 100
+
+type options struct {
+	artifact   string
+	group      string
+	registryID string
+
+	file string
+
+	local         bool
+	previousFiles []string
+	importPaths   []string
+	compatibility string
+	artifactType  string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewCheckCommand creates a new command to check the content of an artifact
+// against the rules of the registry, or locally against previous versions
+func NewCheckCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "check",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.check.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.check.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.check.example"),
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.file = args[0]
+			}
+
+			if opts.local {
+				opts.compatibility = strings.ToUpper(opts.compatibility)
+				if !flagutil.IsValidInput(opts.compatibility, rulecmdutil.CompatibilityConfigs...) {
+					return flagutil.InvalidValueError("compatibility", opts.compatibility, rulecmdutil.CompatibilityConfigs...)
+				}
+
+				opts.artifactType = strings.ToUpper(opts.artifactType)
+				if opts.artifactType != "" && !flagutil.IsValidInput(opts.artifactType, compatcmdutil.ValidArtifactTypes...) {
+					return flagutil.InvalidValueError("type", opts.artifactType, compatcmdutil.ValidArtifactTypes...)
+				}
+
+				if len(opts.previousFiles) > 0 && opts.artifact != "" {
+					return opts.localizer.MustLocalizeError("flag.error.mutuallyExclusive",
+						localize.NewEntry("Flag1", "previous-file"),
+						localize.NewEntry("Flag2", "artifact-id"),
+					)
+				}
+
+				return runLocalCheck(opts)
+			}
+
+			for _, flag := range []string{"previous-file", "import-path", "compatibility", "type"} {
+				if cmd.Flags().Changed(flag) {
+					return opts.localizer.MustLocalizeError("artifact.cmd.check.error.localOnlyFlag", localize.NewEntry("Flag", flag))
+				}
+			}
+
+			if opts.artifact == "" {
+				return opts.localizer.MustLocalizeError("artifact.common.error.artifact.id.required")
+			}
+
+			return runCheck(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", opts.localizer.MustLocalize("artifact.common.file.location"))
+
+	cmd.Flags().StringVar(&opts.artifact, "artifact-id", "", opts.localizer.MustLocalize("artifact.common.id"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", util.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.instance.id"))
+
+	cmd.Flags().BoolVar(&opts.local, "local", false, opts.localizer.MustLocalize("artifact.cmd.check.flag.local.description"))
+	cmd.Flags().StringArrayVar(&opts.previousFiles, "previous-file", []string{}, opts.localizer.MustLocalize("artifact.cmd.check.flag.previousFile.description"))
+	cmd.Flags().StringArrayVar(&opts.importPaths, "import-path", []string{}, opts.localizer.MustLocalize("artifact.cmd.check.flag.importPath.description"))
+	cmd.Flags().StringVar(&opts.compatibility, "compatibility", compatcmdutil.Backward, flagutil.FlagDescription(opts.localizer, "artifact.cmd.check.flag.compatibility.description", append([]string{}, rulecmdutil.CompatibilityConfigs...)...))
+	cmd.Flags().StringVar(&opts.artifactType, "type", "", flagutil.FlagDescription(opts.localizer, "artifact.cmd.check.flag.type.description", append([]string{}, compatcmdutil.ValidArtifactTypes...)...))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "compatibility", rulecmdutil.CompatibilityConfigs)
+	flagutil.EnableStaticFlagCompletion(cmd, "type", compatcmdutil.ValidArtifactTypes)
+
+	return cmd
+}
+
+// selectRegistry uses the current Service Registry instance when the "--instance-id" flag is not set
+func selectRegistry(opts *options) error {
+	if opts.registryID != "" {
+		return nil
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	instanceID, ok := cfg.GetServiceRegistryIdOk()
+	if !ok {
+		return opts.localizer.MustLocalizeError("artifact.cmd.common.error.noServiceRegistrySelected")
+	}
+
+	opts.registryID = instanceID
+	return nil
+}
+
+func newDataAPI(opts *options) (*registryinstanceclient.APIClient, error) {
+	if err := selectRegistry(opts); err != nil {
+		return nil, err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	return dataAPI, err
+}
+
+func runCheck(opts *options) error {
+	dataAPI, err := newDataAPI(opts)
+	if err != nil {
+		return err
+	}
+
+	specifiedFile, err := openFile(opts, opts.file)
+	if err != nil {
+		return err
+	}
+	defer specifiedFile.Close()
+
+	_, err = dataAPI.ArtifactRulesApi.TestUpdateArtifact(opts.Context, opts.group, opts.artifact).Body(specifiedFile).Execute()
+	if err != nil {
+		if violation, ok := registrycmdutil.GetRuleViolationError(err); ok {
+			for _, cause := range violation.GetCauses() {
+				opts.Logger.Info(icon.ErrorPrefix(), formatCause(cause.GetContext(), cause.GetDescription()))
+			}
+			return opts.localizer.MustLocalizeError("artifact.cmd.check.error.ruleViolation",
+				localize.NewEntry("Group", opts.group),
+				localize.NewEntry("ArtifactID", opts.artifact),
+				localize.NewEntry("Message", violation.GetMessage()),
+			)
+		}
+		return artifactError(opts, err)
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.check.log.info.compliant",
+		localize.NewEntry("Group", opts.group),
+		localize.NewEntry("ArtifactID", opts.artifact),
+	))
+
+	return nil
+}
+
+func runLocalCheck(opts *options) error {
+	content, err := readFile(opts, opts.file)
+	if err != nil {
+		return err
+	}
+
+	if opts.artifactType == "" {
+		opts.artifactType = compatcmdutil.DetectArtifactType(content)
+	}
+
+	// the imports of a Protobuf schema are also read relative to the schema file
+	importPaths := opts.importPaths
+	if opts.file != "" && !util.IsURL(opts.file) {
+		importPaths = append([]string{filepath.Dir(opts.file)}, importPaths...)
+	}
+
+	if err = compatcmdutil.ValidateSyntax(opts.artifactType, content, importPaths...); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.check.log.info.validSyntax", localize.NewEntry("Type", opts.artifactType)))
+
+	if opts.compatibility == compatcmdutil.None || (len(opts.previousFiles) == 0 && opts.artifact == "") {
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.cmd.check.log.info.compatibilityNotChecked"))
+		return nil
+	}
+
+	previous, previousNames, err := readPreviousVersions(opts)
+	if err != nil {
+		return err
+	}
+
+	incompatibilities, err := compatcmdutil.CheckCompatibility(opts.artifactType, opts.compatibility, content, previous, importPaths...)
+	if err != nil {
+		return err
+	}
+
+	if len(incompatibilities) > 0 {
+		for _, incompatibility := range incompatibilities {
+			opts.Logger.Info(icon.ErrorPrefix(), formatCause(previousNames[incompatibility.Version], incompatibility.Description))
+		}
+		return opts.localizer.MustLocalizeError("artifact.cmd.check.error.incompatible", localize.NewEntry("Compatibility", opts.compatibility))
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.check.log.info.compatible",
+		localize.NewEntry("Compatibility", opts.compatibility),
+	))
+
+	return nil
+}
+
+// readPreviousVersions returns the content and the names of the previous versions, ordered from the oldest to the latest.
+// They are read from the "--previous-file" flags, or downloaded from Service Registry
+func readPreviousVersions(opts *options) ([][]byte, []string, error) {
+	if len(opts.previousFiles) > 0 {
+		previous := make([][]byte, len(opts.previousFiles))
+		for i, file := range opts.previousFiles {
+			content, err := readFile(opts, file)
+			if err != nil {
+				return nil, nil, err
+			}
+			previous[i] = content
+		}
+		return previous, opts.previousFiles, nil
+	}
+
+	dataAPI, err := newDataAPI(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// non-transitive levels only check the latest version
+	if !strings.HasSuffix(opts.compatibility, "_TRANSITIVE") {
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.fetching.latest"))
+
+		dataFile, _, err := dataAPI.ArtifactsApi.GetLatestArtifact(opts.Context, opts.group, opts.artifact).Execute()
+		if err != nil {
+			return nil, nil, artifactError(opts, err)
+		}
+
+		content, err := ioutil.ReadFile(dataFile.Name())
+		if err != nil {
+			return nil, nil, err
+		}
+		return [][]byte{content}, []string{opts.localizer.MustLocalize("artifact.cmd.check.latestVersion")}, nil
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.artifact.versions.fetching"))
+
+	var versions []string
+	for {
+		results, _, err := dataAPI.VersionsApi.ListArtifactVersions(opts.Context, opts.group, opts.artifact).
+			Offset(int32(len(versions))).
+			Limit(versionsPageSize).
+			Execute()
+		if err != nil {
+			return nil, nil, artifactError(opts, err)
+		}
+
+		for _, v := range results.GetVersions() {
+			versions = append(versions, v.GetVersion())
+		}
+
+		if len(results.GetVersions()) == 0 || len(versions) >= int(results.GetCount()) {
+			break
+		}
+	}
+
+	previous := make([][]byte, len(versions))
+	names := make([]string, len(versions))
+	for i, version := range versions {
+		dataFile, _, err := dataAPI.VersionsApi.GetArtifactVersion(opts.Context, opts.group, opts.artifact, version).Execute()
+		if err != nil {
+			return nil, nil, artifactError(opts, err)
+		}
+
+		if previous[i], err = ioutil.ReadFile(dataFile.Name()); err != nil {
+			return nil, nil, err
+		}
+		names[i] = opts.localizer.MustLocalize("artifact.cmd.check.version", localize.NewEntry("Version", version))
+	}
+
+	return previous, names, nil
+}
+
+func artifactError(opts *options, err error) error {
+	if rulecmdutil.IsErrorCode(err, http.StatusNotFound) {
+		return opts.localizer.MustLocalizeError("artifact.cmd.check.error.artifactNotFound",
+			localize.NewEntry("Group", opts.group),
+			localize.NewEntry("ArtifactID", opts.artifact),
+		)
+	}
+	return registrycmdutil.TransformInstanceError(err)
+}
+
+// openFile opens a local file, downloads a file from a URL, or reads from the standard input when no file is specified
+func openFile(opts *options, file string) (*os.File, error) {
+	switch {
+	case file == "":
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.reading.file"))
+		return util.CreateFileFromStdin()
+	case util.IsURL(file):
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.loading.file", localize.NewEntry("FileName", file)))
+		return util.GetContentFromFileURL(opts.Context, file)
+	default:
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.opening.file", localize.NewEntry("FileName", file)))
+		return os.Open(file)
+	}
+}
+
+func readFile(opts *options, file string) ([]byte, error) {
+	f, err := openFile(opts, file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ioutil.ReadAll(f)
+}
+
+func formatCause(context string, description string) string {
+	if context == "" {
+		return description
+	}
+	return context + ": " + description
+}
@@ -0,0 +1,321 @@
+package compatcmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+var avroPrimitiveTypes = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// avroPromotions maps the type of the writer to the types which can read it
+var avroPromotions = map[string][]string{
+	"int":    {"long", "float", "double"},
+	"long":   {"float", "double"},
+	"float":  {"double"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+// avroSchema is the subset of an Avro schema which is relevant for schema resolution
+type avroSchema struct {
+	kind string
+	// name is the full name of records, enums and fixed types
+	name        string
+	fields      []avroField
+	symbols     []string
+	enumDefault bool
+	items       *avroSchema
+	values      *avroSchema
+	size        int
+	branches    []*avroSchema
+}
+
+type avroField struct {
+	name       string
+	aliases    []string
+	schema     *avroSchema
+	hasDefault bool
+}
+
+func parseAvroSchema(content []byte) (*avroSchema, error) {
+	if _, err := goavro.NewCodec(string(content)); err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+
+	p := &avroParser{named: map[string]*avroSchema{}}
+	return p.parse(doc, "")
+}
+
+type avroParser struct {
+	named map[string]*avroSchema
+}
+
+func (p *avroParser) parse(doc interface{}, namespace string) (*avroSchema, error) {
+	switch v := doc.(type) {
+	case string:
+		return p.resolveName(v, namespace)
+	case []interface{}:
+		union := &avroSchema{kind: "union"}
+		for _, branch := range v {
+			s, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.branches = append(union.branches, s)
+		}
+		return union, nil
+	case map[string]interface{}:
+		return p.parseComplex(v, namespace)
+	default:
+		return nil, fmt.Errorf("invalid Avro schema: unexpected %v", doc)
+	}
+}
+
+func (p *avroParser) parseComplex(doc map[string]interface{}, namespace string) (*avroSchema, error) {
+	typeName, ok := doc["type"].(string)
+	if !ok {
+		return p.parse(doc["type"], namespace)
+	}
+
+	switch typeName {
+	case "record", "error", "enum", "fixed":
+		name, _ := doc["name"].(string)
+		if ns, ok := doc["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+		s := &avroSchema{kind: typeName, name: avroFullName(name, namespace)}
+		if typeName == "error" {
+			s.kind = "record"
+		}
+		if i := strings.LastIndex(s.name, "."); i >= 0 {
+			namespace = s.name[:i]
+		}
+		p.named[s.name] = s
+
+		switch s.kind {
+		case "record":
+			fields, _ := doc["fields"].([]interface{})
+			for _, f := range fields {
+				fieldDoc, _ := f.(map[string]interface{})
+				field, err := p.parseField(fieldDoc, namespace)
+				if err != nil {
+					return nil, err
+				}
+				s.fields = append(s.fields, field)
+			}
+		case "enum":
+			symbols, _ := doc["symbols"].([]interface{})
+			for _, symbol := range symbols {
+				s.symbols = append(s.symbols, fmt.Sprint(symbol))
+			}
+			_, s.enumDefault = doc["default"]
+		case "fixed":
+			size, _ := doc["size"].(float64)
+			s.size = int(size)
+		}
+		return s, nil
+	case "array":
+		items, err := p.parse(doc["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{kind: "array", items: items}, nil
+	case "map":
+		values, err := p.parse(doc["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{kind: "map", values: values}, nil
+	default:
+		// primitive types with attributes, such as logical types
+		return p.resolveName(typeName, namespace)
+	}
+}
+
+func (p *avroParser) parseField(doc map[string]interface{}, namespace string) (avroField, error) {
+	field := avroField{}
+	field.name, _ = doc["name"].(string)
+	_, field.hasDefault = doc["default"]
+
+	aliases, _ := doc["aliases"].([]interface{})
+	for _, alias := range aliases {
+		field.aliases = append(field.aliases, fmt.Sprint(alias))
+	}
+
+	s, err := p.parse(doc["type"], namespace)
+	if err != nil {
+		return field, err
+	}
+	field.schema = s
+
+	return field, nil
+}
+
+func (p *avroParser) resolveName(name string, namespace string) (*avroSchema, error) {
+	for _, primitive := range avroPrimitiveTypes {
+		if name == primitive {
+			return &avroSchema{kind: name}, nil
+		}
+	}
+
+	if s, ok := p.named[avroFullName(name, namespace)]; ok {
+		return s, nil
+	}
+	if s, ok := p.named[name]; ok {
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("invalid Avro schema: unknown type %q", name)
+}
+
+func avroFullName(name string, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func avroShortName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func (s *avroSchema) String() string {
+	if s.name != "" {
+		return s.name
+	}
+	return s.kind
+}
+
+func (s *avroSchema) canRead(writer schema) []string {
+	w, ok := writer.(*avroSchema)
+	if !ok {
+		return []string{"the schemas have different artifact types"}
+	}
+
+	r := &avroResolver{visited: map[[2]*avroSchema]bool{}}
+	return r.resolve(s, w, s.String())
+}
+
+// avroResolver applies the schema resolution rules of the Avro specification
+type avroResolver struct {
+	// visited contains the pairs of records which are being resolved, to stop on recursive types
+	visited map[[2]*avroSchema]bool
+}
+
+func (r *avroResolver) resolve(reader *avroSchema, writer *avroSchema, path string) []string {
+	if writer.kind == "union" {
+		var reasons []string
+		for _, branch := range writer.branches {
+			reasons = append(reasons, r.resolve(reader, branch, path)...)
+		}
+		return reasons
+	}
+
+	if reader.kind == "union" {
+		for _, branch := range reader.branches {
+			if len(r.resolve(branch, writer, path)) == 0 {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("%v: type %q is not in the union %v", path, writer, unionTypes(reader))}
+	}
+
+	if reader.kind != writer.kind {
+		for _, promoted := range avroPromotions[writer.kind] {
+			if reader.kind == promoted {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("%v: type %q cannot be read as %q", path, writer, reader)}
+	}
+
+	switch reader.kind {
+	case "record":
+		return r.resolveRecord(reader, writer, path)
+	case "enum":
+		if avroShortName(reader.name) != avroShortName(writer.name) {
+			return []string{fmt.Sprintf("%v: enum %q cannot be read as %q", path, writer, reader)}
+		}
+		var reasons []string
+		for _, symbol := range writer.symbols {
+			if !containsString(reader.symbols, symbol) && !reader.enumDefault {
+				reasons = append(reasons, fmt.Sprintf("%v: symbol %q was removed from enum %q without a default", path, symbol, reader))
+			}
+		}
+		return reasons
+	case "fixed":
+		if avroShortName(reader.name) != avroShortName(writer.name) || reader.size != writer.size {
+			return []string{fmt.Sprintf("%v: fixed %q of size %v cannot be read as %q of size %v", path, writer, writer.size, reader, reader.size)}
+		}
+	case "array":
+		return r.resolve(reader.items, writer.items, path+"[]")
+	case "map":
+		return r.resolve(reader.values, writer.values, path+"{}")
+	}
+
+	return nil
+}
+
+func (r *avroResolver) resolveRecord(reader *avroSchema, writer *avroSchema, path string) []string {
+	if avroShortName(reader.name) != avroShortName(writer.name) {
+		return []string{fmt.Sprintf("%v: record %q cannot be read as %q", path, writer, reader)}
+	}
+
+	key := [2]*avroSchema{reader, writer}
+	if r.visited[key] {
+		return nil
+	}
+	r.visited[key] = true
+
+	var reasons []string
+	for _, field := range reader.fields {
+		fieldPath := path + "." + field.name
+
+		writerField, ok := writer.findField(field)
+		if !ok {
+			if !field.hasDefault {
+				reasons = append(reasons, fmt.Sprintf("%v: field was added without a default value", fieldPath))
+			}
+			continue
+		}
+
+		reasons = append(reasons, r.resolve(field.schema, writerField.schema, fieldPath)...)
+	}
+
+	return reasons
+}
+
+// findField returns the field of the record which matches the name or an alias of the reader field
+func (s *avroSchema) findField(readerField avroField) (avroField, bool) {
+	for _, f := range s.fields {
+		if f.name == readerField.name || containsString(readerField.aliases, f.name) {
+			return f, true
+		}
+	}
+	return avroField{}, false
+}
+
+func unionTypes(union *avroSchema) []string {
+	types := make([]string, len(union.branches))
+	for i, branch := range union.branches {
+		types[i] = branch.String()
+	}
+	return types
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
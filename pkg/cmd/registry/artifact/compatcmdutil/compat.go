@@ -0,0 +1,122 @@
+package compatcmdutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messagecmdutil"
+)
+
+// compatibility levels which can be checked locally
+const (
+	Backward           = "BACKWARD"
+	BackwardTransitive = "BACKWARD_TRANSITIVE"
+	Forward            = "FORWARD"
+	ForwardTransitive  = "FORWARD_TRANSITIVE"
+	Full               = "FULL"
+	FullTransitive     = "FULL_TRANSITIVE"
+	None               = "NONE"
+)
+
+// ValidArtifactTypes are the artifact types which can be checked locally
+var ValidArtifactTypes = []string{
+	messagecmdutil.AvroArtifactType,
+	messagecmdutil.ProtobufArtifactType,
+	messagecmdutil.JSONSchemaArtifactType,
+}
+
+// Incompatibility describes a change which breaks the compatibility with a previous version
+type Incompatibility struct {
+	// Version is the index of the previous version, starting from 0 for the oldest
+	Version     int
+	Description string
+}
+
+// schema is a parsed schema which can be compared with another schema of the same type
+type schema interface {
+	// canRead returns the reasons why data written with the writer schema
+	// cannot be read with this schema
+	canRead(writer schema) []string
+}
+
+// DetectArtifactType infers the artifact type from the content of a schema
+func DetectArtifactType(content []byte) string {
+	return messagecmdutil.DetectArtifactType(content)
+}
+
+// ValidateSyntax checks that the content is a valid schema of the artifact type.
+// The imports of Protobuf schemas are read from the import paths
+func ValidateSyntax(artifactType string, content []byte, importPaths ...string) error {
+	// the keywords of JSON Schemas which cannot be compared are only rejected by CheckCompatibility
+	if strings.ToUpper(artifactType) == messagecmdutil.JSONSchemaArtifactType {
+		return validateJSONSchema(content)
+	}
+
+	_, err := parseSchema(artifactType, content, importPaths)
+	return err
+}
+
+// CheckCompatibility checks the content against the previous versions, ordered from the oldest to the latest,
+// using the rules of the compatibility level. The imports of Protobuf schemas are read from the import paths
+func CheckCompatibility(artifactType string, level string, content []byte, previous [][]byte, importPaths ...string) ([]Incompatibility, error) {
+	level = strings.ToUpper(level)
+
+	current, err := parseSchema(artifactType, content, importPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	if level == None || len(previous) == 0 {
+		return nil, nil
+	}
+
+	first := len(previous) - 1
+	if strings.HasSuffix(level, "_TRANSITIVE") {
+		first = 0
+	}
+
+	var incompatibilities []Incompatibility
+	for i := first; i < len(previous); i++ {
+		existing, err := parseSchema(artifactType, previous[i], importPaths)
+		if err != nil {
+			return nil, fmt.Errorf("previous version %v: %w", i+1, err)
+		}
+
+		var reasons []string
+		switch strings.TrimSuffix(level, "_TRANSITIVE") {
+		case Backward:
+			reasons = current.canRead(existing)
+		case Forward:
+			reasons = existing.canRead(current)
+		case Full:
+			reasons = append(current.canRead(existing), existing.canRead(current)...)
+		default:
+			return nil, fmt.Errorf("unsupported compatibility level %q", level)
+		}
+
+		// symmetric checks report the same reason in both directions
+		seen := map[string]bool{}
+		for _, reason := range reasons {
+			if seen[reason] {
+				continue
+			}
+			seen[reason] = true
+			incompatibilities = append(incompatibilities, Incompatibility{Version: i, Description: reason})
+		}
+	}
+
+	return incompatibilities, nil
+}
+
+func parseSchema(artifactType string, content []byte, importPaths []string) (schema, error) {
+	switch strings.ToUpper(artifactType) {
+	case messagecmdutil.AvroArtifactType:
+		return parseAvroSchema(content)
+	case messagecmdutil.ProtobufArtifactType:
+		return parseProtobufSchema(content, importPaths)
+	case messagecmdutil.JSONSchemaArtifactType:
+		return parseJSONSchema(content)
+	default:
+		return nil, fmt.Errorf("unsupported artifact type %q", artifactType)
+	}
+}
@@ -0,0 +1,343 @@
+package compatcmdutil
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const (
+	avroV1 = `{
+  "type": "record",
+  "name": "Order",
+  "namespace": "shop",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "amount", "type": "int"},
+    {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID"]}}
+  ]
+}`
+
+	// adds a field with a default and promotes amount to long
+	avroV2 = `{
+  "type": "record",
+  "name": "Order",
+  "namespace": "shop",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "amount", "type": "long"},
+    {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID"]}},
+    {"name": "note", "type": ["null", "string"], "default": null}
+  ]
+}`
+
+	// adds a field without a default and removes an enum symbol
+	avroV3 = `{
+  "type": "record",
+  "name": "Order",
+  "namespace": "shop",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "amount", "type": "long"},
+    {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW"]}},
+    {"name": "currency", "type": "string"}
+  ]
+}`
+
+	protobufV1 = `syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+  int32 amount = 2;
+  string note = 3;
+}`
+
+	// removes a field and reserves its number
+	protobufV2 = `syntax = "proto3";
+package shop;
+
+message Order {
+  reserved 3;
+  string id = 1;
+  int64 amount = 2;
+}`
+
+	// removes a field without reserving its number
+	protobufV3 = `syntax = "proto3";
+package shop;
+
+message Order {
+  string id = 1;
+}`
+
+	jsonSchemaV1 = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "id": {"type": "string"},
+    "amount": {"type": "integer"}
+  },
+  "required": ["id"]
+}`
+
+	// widens the type of amount
+	jsonSchemaV2 = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "id": {"type": "string"},
+    "amount": {"type": "number"}
+  },
+  "required": ["id"]
+}`
+
+	// requires amount and restricts the length of id
+	jsonSchemaV3 = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "id": {"type": "string", "maxLength": 10},
+    "amount": {"type": "number"}
+  },
+  "required": ["id", "amount"]
+}`
+)
+
+func TestValidateSyntax(t *testing.T) {
+	tests := []struct {
+		name         string
+		artifactType string
+		content      string
+		wantErr      bool
+	}{
+		{name: "Should accept a valid Avro schema", artifactType: "AVRO", content: avroV1},
+		{name: "Should reject an invalid Avro schema", artifactType: "AVRO", content: `{"type": "record", "name": "Order"}`, wantErr: true},
+		{name: "Should accept a valid Protobuf schema", artifactType: "PROTOBUF", content: protobufV1},
+		{name: "Should reject an invalid Protobuf schema", artifactType: "PROTOBUF", content: `message Order { string id = }`, wantErr: true},
+		{name: "Should accept a valid JSON Schema", artifactType: "JSON", content: jsonSchemaV1},
+		{name: "Should reject an invalid JSON Schema", artifactType: "JSON", content: `{"type": 12}`, wantErr: true},
+		{name: "Should reject an unsupported artifact type", artifactType: "WSDL", content: `<definitions/>`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSyntax(tt.artifactType, []byte(tt.content)); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSyntax() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	tests := []struct {
+		name         string
+		artifactType string
+		level        string
+		content      string
+		previous     []string
+		want         []Incompatibility
+	}{
+		{
+			name:         "Should accept a backward compatible Avro schema",
+			artifactType: "AVRO",
+			level:        Backward,
+			content:      avroV2,
+			previous:     []string{avroV1},
+		},
+		{
+			name:         "Should reject a forward incompatible Avro schema",
+			artifactType: "AVRO",
+			level:        Forward,
+			content:      avroV2,
+			previous:     []string{avroV1},
+			want: []Incompatibility{
+				{Version: 0, Description: `shop.Order.amount: type "long" cannot be read as "int"`},
+			},
+		},
+		{
+			name:         "Should reject a backward incompatible Avro schema",
+			artifactType: "AVRO",
+			level:        Backward,
+			content:      avroV3,
+			previous:     []string{avroV2},
+			want: []Incompatibility{
+				{Version: 0, Description: `shop.Order.status: symbol "PAID" was removed from enum "shop.Status" without a default`},
+				{Version: 0, Description: `shop.Order.currency: field was added without a default value`},
+			},
+		},
+		{
+			name:         "Should only check the latest version when the level is not transitive",
+			artifactType: "AVRO",
+			level:        Forward,
+			content:      avroV3,
+			previous:     []string{avroV1, avroV2},
+		},
+		{
+			name:         "Should check all the versions when the level is transitive",
+			artifactType: "AVRO",
+			level:        ForwardTransitive,
+			content:      avroV3,
+			previous:     []string{avroV1, avroV2},
+			want: []Incompatibility{
+				{Version: 0, Description: `shop.Order.amount: type "long" cannot be read as "int"`},
+			},
+		},
+		{
+			name:         "Should skip the check when the level is NONE",
+			artifactType: "AVRO",
+			level:        None,
+			content:      avroV3,
+			previous:     []string{avroV1},
+		},
+		{
+			name:         "Should accept a Protobuf field removed with a reserved number",
+			artifactType: "PROTOBUF",
+			level:        Full,
+			content:      protobufV2,
+			previous:     []string{protobufV1},
+		},
+		{
+			name:         "Should reject a Protobuf field removed without a reserved number",
+			artifactType: "PROTOBUF",
+			level:        Backward,
+			content:      protobufV3,
+			previous:     []string{protobufV2},
+			want: []Incompatibility{
+				{Version: 0, Description: `shop.Order: field "amount" = 2 was removed without reserving its number`},
+			},
+		},
+		{
+			name:         "Should accept a backward compatible JSON Schema",
+			artifactType: "JSON",
+			level:        Backward,
+			content:      jsonSchemaV2,
+			previous:     []string{jsonSchemaV1},
+		},
+		{
+			name:         "Should reject a forward incompatible JSON Schema",
+			artifactType: "JSON",
+			level:        Forward,
+			content:      jsonSchemaV2,
+			previous:     []string{jsonSchemaV1},
+			want: []Incompatibility{
+				{Version: 0, Description: `#/properties/amount: type "number" is not allowed anymore`},
+			},
+		},
+		{
+			name:         "Should reject a backward incompatible JSON Schema",
+			artifactType: "JSON",
+			level:        Backward,
+			content:      jsonSchemaV3,
+			previous:     []string{jsonSchemaV2},
+			want: []Incompatibility{
+				{Version: 0, Description: `#: property "amount" is now required`},
+				{Version: 0, Description: `#/properties/id: maxLength was restricted to 10`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := make([][]byte, len(tt.previous))
+			for i, p := range tt.previous {
+				previous[i] = []byte(p)
+			}
+
+			got, err := CheckCompatibility(tt.artifactType, tt.level, []byte(tt.content), previous)
+			if err != nil {
+				t.Fatalf("CheckCompatibility() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckCompatibility() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckJSONSchemaWithUnsupportedKeywords(t *testing.T) {
+	withRef := `{
+  "type": "object",
+  "properties": {
+    "id": {"type": "string"},
+    "amount": {"$ref": "#/definitions/money"}
+  },
+  "definitions": {
+    "money": {"type": "number"}
+  }
+}`
+
+	if err := ValidateSyntax("JSON", []byte(withRef)); err != nil {
+		t.Fatalf("ValidateSyntax() error = %v", err)
+	}
+
+	_, err := CheckCompatibility("JSON", Backward, []byte(withRef), [][]byte{[]byte(jsonSchemaV1)})
+	if err == nil {
+		t.Fatal("CheckCompatibility() of a JSON Schema with a reference should return an error")
+	}
+	if want := `#/properties/amount: the compatibility of JSON Schemas using "$ref" cannot be checked locally`; err.Error() != want {
+		t.Errorf("CheckCompatibility() error = %q, want %q", err, want)
+	}
+
+	if _, err := CheckCompatibility("JSON", Forward, []byte(jsonSchemaV1), [][]byte{[]byte(withRef)}); err == nil {
+		t.Error("CheckCompatibility() against a previous JSON Schema with a reference should return an error")
+	}
+}
+
+func TestCheckProtobufSchemaWithImports(t *testing.T) {
+	importPath := t.TempDir()
+	money := `syntax = "proto3";
+package shop;
+
+message Money {
+  string currency = 1;
+  int64 units = 2;
+}`
+	if err := ioutil.WriteFile(filepath.Join(importPath, "money.proto"), []byte(money), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	order := `syntax = "proto3";
+package shop;
+
+import "money.proto";
+import "google/protobuf/timestamp.proto";
+
+message Order {
+  string id = 1;
+  Money total = 2;
+  google.protobuf.Timestamp created_at = 3;
+}`
+
+	if err := ValidateSyntax("PROTOBUF", []byte(order)); err == nil {
+		t.Error("ValidateSyntax() without import paths should return an error")
+	}
+
+	got, err := CheckCompatibility("PROTOBUF", Full, []byte(order), [][]byte{[]byte(order)}, importPath)
+	if err != nil {
+		t.Fatalf("CheckCompatibility() error = %v", err)
+	}
+	if len(got) > 0 {
+		t.Errorf("CheckCompatibility() = %v, want no incompatibilities", got)
+	}
+}
+
+func TestCheckRecursiveAvroSchema(t *testing.T) {
+	tree := `{
+  "type": "record",
+  "name": "Node",
+  "fields": [
+    {"name": "value", "type": "int"},
+    {"name": "children", "type": {"type": "array", "items": "Node"}}
+  ]
+}`
+
+	got, err := CheckCompatibility("AVRO", FullTransitive, []byte(tree), [][]byte{[]byte(tree)})
+	if err != nil {
+		t.Fatalf("CheckCompatibility() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("CheckCompatibility() = %v, want no incompatibilities", got)
+	}
+}
@@ -0,0 +1,232 @@
+package compatcmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// keywords which restrict the values accepted by a JSON Schema when their value decreases
+var jsonSchemaUpperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+
+// keywords which restrict the values accepted by a JSON Schema when their value increases
+var jsonSchemaLowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+
+// keywords which cannot be compared locally: references are not resolved,
+// and the combinations of schemas are not expanded
+var jsonSchemaUnsupportedKeywords = []string{"$ref", "allOf", "anyOf", "oneOf", "not", "if"}
+
+// jsonSchema is a JSON Schema document.
+// A reader schema can read a writer schema when it accepts all the values accepted by the writer schema
+type jsonSchema struct {
+	doc map[string]interface{}
+}
+
+// validateJSONSchema checks the syntax of a JSON Schema
+func validateJSONSchema(content []byte) error {
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(content)); err != nil {
+		return fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+	return nil
+}
+
+// parseJSONSchema parses a JSON Schema whose compatibility can be checked locally.
+// An error is returned when the schema uses keywords which are not compared,
+// so that a check cannot succeed without covering the whole schema
+func parseJSONSchema(content []byte) (*jsonSchema, error) {
+	if err := validateJSONSchema(content); err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+
+	s := &jsonSchema{doc: jsonSchemaObject(doc)}
+	if path, keyword, ok := findUnsupportedJSONSchemaKeyword(s.doc, "#"); ok {
+		return nil, fmt.Errorf("%v: the compatibility of JSON Schemas using %q cannot be checked locally", path, keyword)
+	}
+
+	return s, nil
+}
+
+func (s *jsonSchema) canRead(writer schema) []string {
+	w, ok := writer.(*jsonSchema)
+	if !ok {
+		return []string{"the schemas have different artifact types"}
+	}
+
+	return compareJSONSchemas(s.doc, w.doc, "#")
+}
+
+func compareJSONSchemas(reader map[string]interface{}, writer map[string]interface{}, path string) []string {
+	var reasons []string
+
+	if readerTypes := jsonSchemaTypes(reader); readerTypes != nil {
+		writerTypes := jsonSchemaTypes(writer)
+		if writerTypes == nil {
+			reasons = append(reasons, fmt.Sprintf("%v: type was restricted to %v", path, readerTypes))
+		}
+		for _, t := range writerTypes {
+			if !containsString(readerTypes, t) && !(t == "integer" && containsString(readerTypes, "number")) {
+				reasons = append(reasons, fmt.Sprintf("%v: type %q is not allowed anymore", path, t))
+			}
+		}
+	}
+
+	if readerEnum, ok := reader["enum"].([]interface{}); ok {
+		writerEnum, ok := writer["enum"].([]interface{})
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("%v: values were restricted to an enum", path))
+		}
+		for _, value := range writerEnum {
+			if !containsValue(readerEnum, value) {
+				reasons = append(reasons, fmt.Sprintf("%v: enum value %v was removed", path, value))
+			}
+		}
+	}
+
+	writerRequired := jsonSchemaStrings(writer["required"])
+	for _, name := range jsonSchemaStrings(reader["required"]) {
+		if !containsString(writerRequired, name) {
+			reasons = append(reasons, fmt.Sprintf("%v: property %q is now required", path, name))
+		}
+	}
+
+	readerProperties := jsonSchemaProperties(reader)
+	writerProperties := jsonSchemaProperties(writer)
+	readerAdditional, readerAdditionalIsSchema := reader["additionalProperties"].(map[string]interface{})
+	for _, name := range sortedKeys(writerProperties) {
+		propertyPath := path + "/properties/" + name
+		if readerProperty, ok := readerProperties[name]; ok {
+			reasons = append(reasons, compareJSONSchemas(readerProperty, writerProperties[name], propertyPath)...)
+		} else if reader["additionalProperties"] == false {
+			reasons = append(reasons, fmt.Sprintf("%v: property was removed and additional properties are not allowed", propertyPath))
+		} else if readerAdditionalIsSchema {
+			reasons = append(reasons, compareJSONSchemas(readerAdditional, writerProperties[name], propertyPath)...)
+		}
+	}
+
+	if reader["additionalProperties"] == false && writer["additionalProperties"] != false {
+		reasons = append(reasons, fmt.Sprintf("%v: additional properties are not allowed anymore", path))
+	}
+
+	if readerItems, ok := reader["items"].(map[string]interface{}); ok {
+		if writerItems, ok := writer["items"].(map[string]interface{}); ok {
+			reasons = append(reasons, compareJSONSchemas(readerItems, writerItems, path+"/items")...)
+		}
+	}
+
+	for _, keyword := range jsonSchemaUpperBounds {
+		if r, ok := reader[keyword].(float64); ok {
+			if w, ok := writer[keyword].(float64); !ok || w > r {
+				reasons = append(reasons, fmt.Sprintf("%v: %v was restricted to %v", path, keyword, r))
+			}
+		}
+	}
+
+	for _, keyword := range jsonSchemaLowerBounds {
+		if r, ok := reader[keyword].(float64); ok {
+			if w, ok := writer[keyword].(float64); !ok || w < r {
+				reasons = append(reasons, fmt.Sprintf("%v: %v was restricted to %v", path, keyword, r))
+			}
+		}
+	}
+
+	if pattern, ok := reader["pattern"].(string); ok && writer["pattern"] != pattern {
+		reasons = append(reasons, fmt.Sprintf("%v: pattern was changed to %q", path, pattern))
+	}
+
+	return reasons
+}
+
+// findUnsupportedJSONSchemaKeyword returns the path and the first keyword which cannot be compared,
+// in the parts of the schema visited by compareJSONSchemas
+func findUnsupportedJSONSchemaKeyword(doc map[string]interface{}, path string) (string, string, bool) {
+	for _, keyword := range jsonSchemaUnsupportedKeywords {
+		if _, ok := doc[keyword]; ok {
+			return path, keyword, true
+		}
+	}
+
+	properties := jsonSchemaProperties(doc)
+	for _, name := range sortedKeys(properties) {
+		if p, k, ok := findUnsupportedJSONSchemaKeyword(properties[name], path+"/properties/"+name); ok {
+			return p, k, true
+		}
+	}
+
+	if additional, ok := doc["additionalProperties"].(map[string]interface{}); ok {
+		if p, k, ok := findUnsupportedJSONSchemaKeyword(additional, path+"/additionalProperties"); ok {
+			return p, k, true
+		}
+	}
+
+	if items, ok := doc["items"].(map[string]interface{}); ok {
+		return findUnsupportedJSONSchemaKeyword(items, path+"/items")
+	}
+
+	return "", "", false
+}
+
+// jsonSchemaObject returns the keywords of a schema, where the boolean schemas have no keywords
+func jsonSchemaObject(doc interface{}) map[string]interface{} {
+	if obj, ok := doc.(map[string]interface{}); ok {
+		return obj
+	}
+	return map[string]interface{}{}
+}
+
+func jsonSchemaTypes(doc map[string]interface{}) []string {
+	switch t := doc["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		return jsonSchemaStrings(t)
+	default:
+		return nil
+	}
+}
+
+func jsonSchemaProperties(doc map[string]interface{}) map[string]map[string]interface{} {
+	properties := map[string]map[string]interface{}{}
+	if obj, ok := doc["properties"].(map[string]interface{}); ok {
+		for name, property := range obj {
+			properties[name] = jsonSchemaObject(property)
+		}
+	}
+	return properties
+}
+
+func jsonSchemaStrings(value interface{}) []string {
+	values, _ := value.([]interface{})
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
+
+func sortedKeys(m map[string]map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package compatcmdutil
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+)
+
+const protobufSchemaFile = "schema.proto"
+
+// protobufWireTypes groups the scalar types which share the same wire encoding
+var protobufWireTypes = map[string]string{
+	"TYPE_INT32":    "varint",
+	"TYPE_UINT32":   "varint",
+	"TYPE_INT64":    "varint",
+	"TYPE_UINT64":   "varint",
+	"TYPE_BOOL":     "varint",
+	"TYPE_SINT32":   "zigzag",
+	"TYPE_SINT64":   "zigzag",
+	"TYPE_FIXED32":  "fixed32",
+	"TYPE_SFIXED32": "fixed32",
+	"TYPE_FIXED64":  "fixed64",
+	"TYPE_SFIXED64": "fixed64",
+	"TYPE_STRING":   "length-delimited",
+	"TYPE_BYTES":    "length-delimited",
+}
+
+// protobufSchema is a parsed .proto file.
+// Since both sides of a Protobuf schema change must agree on the field numbers, most checks are symmetric
+type protobufSchema struct {
+	messages map[string]*desc.MessageDescriptor
+	enums    map[string]*desc.EnumDescriptor
+}
+
+func parseProtobufSchema(content []byte, importPaths []string) (*protobufSchema, error) {
+	parser := protoparse.Parser{
+		// the well-known types of Protobuf, such as "google/protobuf/timestamp.proto", are built into the parser
+		Accessor: func(filename string) (io.ReadCloser, error) {
+			if filename == protobufSchemaFile {
+				return ioutil.NopCloser(strings.NewReader(string(content))), nil
+			}
+			return openProtobufImport(filename, importPaths)
+		},
+	}
+
+	files, err := parser.ParseFiles(protobufSchemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Protobuf schema: %w", err)
+	}
+
+	s := &protobufSchema{
+		messages: map[string]*desc.MessageDescriptor{},
+		enums:    map[string]*desc.EnumDescriptor{},
+	}
+	s.addEnums(files[0].GetEnumTypes())
+	s.addMessages(files[0].GetMessageTypes())
+
+	return s, nil
+}
+
+// openProtobufImport opens an imported file from the first import path which contains it
+func openProtobufImport(filename string, importPaths []string) (io.ReadCloser, error) {
+	for _, importPath := range importPaths {
+		f, err := os.Open(filepath.Join(importPath, filename))
+		if err == nil {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("%v: %w", filename, os.ErrNotExist)
+}
+
+func (s *protobufSchema) addMessages(messages []*desc.MessageDescriptor) {
+	for _, m := range messages {
+		// map entries are compared with the map fields
+		if m.IsMapEntry() {
+			continue
+		}
+		s.messages[m.GetFullyQualifiedName()] = m
+		s.addEnums(m.GetNestedEnumTypes())
+		s.addMessages(m.GetNestedMessageTypes())
+	}
+}
+
+func (s *protobufSchema) addEnums(enums []*desc.EnumDescriptor) {
+	for _, e := range enums {
+		s.enums[e.GetFullyQualifiedName()] = e
+	}
+}
+
+func (s *protobufSchema) canRead(writer schema) []string {
+	w, ok := writer.(*protobufSchema)
+	if !ok {
+		return []string{"the schemas have different artifact types"}
+	}
+
+	var reasons []string
+
+	for _, name := range sortedMessageNames(w.messages) {
+		readerMessage, ok := s.messages[name]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("%v: message was removed", name))
+			continue
+		}
+		reasons = append(reasons, compareProtobufMessages(readerMessage, w.messages[name])...)
+	}
+
+	for _, name := range sortedEnumNames(w.enums) {
+		readerEnum, ok := s.enums[name]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("%v: enum was removed", name))
+			continue
+		}
+		reasons = append(reasons, compareProtobufEnums(readerEnum, w.enums[name])...)
+	}
+
+	return reasons
+}
+
+func compareProtobufMessages(reader *desc.MessageDescriptor, writer *desc.MessageDescriptor) []string {
+	var reasons []string
+	name := reader.GetFullyQualifiedName()
+
+	for _, writerField := range writer.GetFields() {
+		number := writerField.GetNumber()
+		readerField := reader.FindFieldByNumber(number)
+		if readerField == nil {
+			if !isReservedFieldNumber(reader, number) {
+				reasons = append(reasons, fmt.Sprintf("%v: field %q = %v was removed without reserving its number", name, writerField.GetName(), number))
+			}
+			continue
+		}
+
+		if readerField.GetName() != writerField.GetName() {
+			reasons = append(reasons, fmt.Sprintf("%v: field %v was renamed from %q to %q", name, number, writerField.GetName(), readerField.GetName()))
+		}
+		if readerField.IsRepeated() != writerField.IsRepeated() {
+			reasons = append(reasons, fmt.Sprintf("%v: field %q changed its cardinality", name, readerField.GetName()))
+		}
+		if protobufFieldType(readerField) != protobufFieldType(writerField) {
+			reasons = append(reasons, fmt.Sprintf("%v: field %q changed its type from %v to %v", name, readerField.GetName(), protobufFieldType(writerField), protobufFieldType(readerField)))
+		}
+	}
+
+	for _, readerField := range reader.GetFields() {
+		number := readerField.GetNumber()
+		if writer.FindFieldByNumber(number) == nil && readerField.IsRequired() {
+			reasons = append(reasons, fmt.Sprintf("%v: required field %q was added", name, readerField.GetName()))
+		}
+	}
+
+	return reasons
+}
+
+func compareProtobufEnums(reader *desc.EnumDescriptor, writer *desc.EnumDescriptor) []string {
+	var reasons []string
+	name := reader.GetFullyQualifiedName()
+
+	for _, value := range writer.GetValues() {
+		number := value.GetNumber()
+		if reader.FindValueByNumber(number) != nil {
+			continue
+		}
+		if !isReservedEnumNumber(reader, number) {
+			reasons = append(reasons, fmt.Sprintf("%v: value %q = %v was removed without reserving its number", name, value.GetName(), number))
+		}
+	}
+
+	return reasons
+}
+
+// protobufFieldType returns the type of the field, where scalar types with the same encoding are equivalent
+func protobufFieldType(field *desc.FieldDescriptor) string {
+	if m := field.GetMessageType(); m != nil {
+		return m.GetFullyQualifiedName()
+	}
+	if e := field.GetEnumType(); e != nil {
+		return e.GetFullyQualifiedName()
+	}
+
+	fieldType := field.GetType().String()
+	if wireType, ok := protobufWireTypes[fieldType]; ok {
+		return wireType
+	}
+	return fieldType
+}
+
+func isReservedFieldNumber(message *desc.MessageDescriptor, number int32) bool {
+	for _, r := range message.AsDescriptorProto().GetReservedRange() {
+		// the end of message reserved ranges is exclusive
+		if number >= r.GetStart() && number < r.GetEnd() {
+			return true
+		}
+	}
+	return false
+}
+
+func isReservedEnumNumber(enum *desc.EnumDescriptor, number int32) bool {
+	for _, r := range enum.AsEnumDescriptorProto().GetReservedRange() {
+		// the end of enum reserved ranges is inclusive
+		if number >= r.GetStart() && number <= r.GetEnd() {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedMessageNames(messages map[string]*desc.MessageDescriptor) []string {
+	names := make([]string, 0, len(messages))
+	for name := range messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedEnumNames(enums map[string]*desc.EnumDescriptor) []string {
+	names := make([]string, 0, len(enums))
+	for name := range enums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
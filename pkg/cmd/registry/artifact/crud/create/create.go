@@ -2,8 +2,10 @@ package create
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 
+	"github.com/redhat-developer/app-services-cli/pkg/api/artifactreference"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/serviceregistryutil"
 	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+	registrymgmtclient "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
 
 	"github.com/spf13/cobra"
 )
@@ -33,6 +36,9 @@ type options struct {
 	name        string
 	description string
 
+	references    []string
+	referencesDir string
+
 	registryID   string
 	outputFormat string
 
@@ -70,6 +76,12 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 				opts.file = args[0]
 			}
 
+			for _, reference := range opts.references {
+				if _, err := util.ParseReference(reference); err != nil {
+					return err
+				}
+			}
+
 			if opts.artifactType != "" {
 				if _, err := registryinstanceclient.NewArtifactTypeFromValue(opts.artifactType); err != nil {
 					return opts.localizer.MustLocalizeError("artifact.cmd.create.error.invalidArtifactType", localize.NewEntry("AllowedTypes", util.GetAllowedArtifactTypeEnumValuesAsString()))
@@ -104,6 +116,8 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.version, "version", "", opts.localizer.MustLocalize("artifact.common.custom.version"))
 	cmd.Flags().StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("artifact.common.custom.name"))
 	cmd.Flags().StringVar(&opts.description, "description", "", opts.localizer.MustLocalize("artifact.common.custom.description"))
+	cmd.Flags().StringArrayVar(&opts.references, "reference", []string{}, opts.localizer.MustLocalize("artifact.common.reference"))
+	cmd.Flags().StringVar(&opts.referencesDir, "references-dir", "", opts.localizer.MustLocalize("artifact.common.referencesDir"))
 
 	cmd.Flags().StringVarP(&opts.artifactType, "type", "t", "", opts.localizer.MustLocalize("artifact.common.type", localize.NewEntry("AllowedTypes", util.GetAllowedArtifactTypeEnumValuesAsString())))
 	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.instance.id"))
@@ -161,6 +175,10 @@ func runCreate(opts *options) error {
 		}
 	}
 
+	if len(opts.references) > 0 || opts.referencesDir != "" {
+		return createWithReferences(opts, dataAPI, registry, specifiedFile)
+	}
+
 	request := dataAPI.ArtifactsApi.CreateArtifact(opts.Context, opts.group)
 	if opts.artifactType != "" {
 		request = request.XRegistryArtifactType(registryinstanceclient.ArtifactType(opts.artifactType))
@@ -193,3 +211,41 @@ func runCreate(opts *options) error {
 
 	return dump.Formatted(opts.IO.Out, opts.outputFormat, metadata)
 }
+
+// createWithReferences creates the artifact with the API for artifact references,
+// since the references cannot be sent with the SDK
+func createWithReferences(opts *options, dataAPI *registryinstanceclient.APIClient, registry *registrymgmtclient.Registry, specifiedFile *os.File) error {
+	content, err := ioutil.ReadAll(specifiedFile)
+	if err != nil {
+		return err
+	}
+
+	references, err := util.ResolveReferences(opts.Context, dataAPI, content, opts.references, opts.referencesDir, opts.group)
+	if err != nil {
+		return err
+	}
+
+	request := artifactreference.CreateArtifactRequest{
+		ArtifactID:   opts.artifact,
+		ArtifactType: opts.artifactType,
+		Version:      opts.version,
+		Name:         opts.name,
+		Description:  opts.description,
+		Content:      content,
+		References:   references,
+	}
+
+	metadata, _, err := artifactreference.NewAPIClientFromInstance(dataAPI).CreateArtifact(opts.Context, opts.group, request)
+	if err != nil {
+		return err
+	}
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("artifact.common.message.createdWithReferences", len(references), localize.NewEntry("Count", len(references))))
+
+	artifactURL, ok := util.GetArtifactURL(registry, metadata)
+
+	if ok {
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.webURL", localize.NewEntry("URL", color.Info(artifactURL))))
+	}
+
+	return dump.Formatted(opts.IO.Out, opts.outputFormat, metadata)
+}
@@ -6,17 +6,21 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/redhat-developer/app-services-cli/pkg/api/artifactreference"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +31,7 @@ type options struct {
 
 	registryID string
 	version    string
+	references bool
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -81,6 +86,7 @@ func NewGetCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.instance.id"))
 	cmd.Flags().StringVar(&opts.outputFile, "output-file", "", opts.localizer.MustLocalize("artifact.common.message.file.location"))
 	cmd.Flags().StringVar(&opts.version, "version", "", opts.localizer.MustLocalize("artifact.common.version"))
+	cmd.Flags().BoolVar(&opts.references, "references", false, opts.localizer.MustLocalize("artifact.common.references"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -131,5 +137,45 @@ func runGet(opts *options) error {
 	}
 
 	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.common.message.fetched.successfully"))
+
+	if opts.references {
+		return printReferences(opts, dataAPI)
+	}
+
+	return nil
+}
+
+// printReferences prints the references of the artifact version to the standard error,
+// so that the standard output only contains the content
+func printReferences(opts *options, dataAPI *registryinstanceclient.APIClient) error {
+	var globalID int64
+	if opts.version != "" {
+		metadata, _, err := dataAPI.MetadataApi.GetArtifactVersionMetaData(opts.Context, opts.group, opts.artifact, opts.version).Execute()
+		if err != nil {
+			return registrycmdutil.TransformInstanceError(err)
+		}
+		globalID = metadata.GetGlobalId()
+	} else {
+		metadata, _, err := dataAPI.MetadataApi.GetArtifactMetaData(opts.Context, opts.group, opts.artifact).Execute()
+		if err != nil {
+			return registrycmdutil.TransformInstanceError(err)
+		}
+		globalID = metadata.GetGlobalId()
+	}
+
+	references, err := util.GetArtifactReferences(opts.Context, artifactreference.NewAPIClientFromInstance(dataAPI), globalID)
+	if err != nil {
+		return err
+	}
+
+	rows := util.MapReferencesToTableRows(references)
+	if len(rows) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.noReferences"))
+		return nil
+	}
+
+	opts.Logger.Info("")
+	dump.Table(opts.IO.ErrOut, rows)
+
 	return nil
 }
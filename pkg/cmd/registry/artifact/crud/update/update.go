@@ -2,8 +2,10 @@ package update
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 
+	"github.com/redhat-developer/app-services-cli/pkg/api/artifactreference"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
@@ -13,6 +15,8 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+
 	"github.com/spf13/cobra"
 )
 
@@ -28,6 +32,9 @@ type options struct {
 	name        string
 	description string
 
+	references    []string
+	referencesDir string
+
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
 	Connection factory.ConnectionFunc
@@ -62,6 +69,12 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 				opts.file = args[0]
 			}
 
+			for _, reference := range opts.references {
+				if _, err := util.ParseReference(reference); err != nil {
+					return err
+				}
+			}
+
 			if opts.registryID != "" {
 				return runUpdate(opts)
 			}
@@ -90,6 +103,8 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.version, "version", "", opts.localizer.MustLocalize("artifact.common.custom.version"))
 	cmd.Flags().StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("artifact.common.custom.name"))
 	cmd.Flags().StringVar(&opts.description, "description", "", opts.localizer.MustLocalize("artifact.common.custom.description"))
+	cmd.Flags().StringArrayVar(&opts.references, "reference", []string{}, opts.localizer.MustLocalize("artifact.common.reference"))
+	cmd.Flags().StringVar(&opts.referencesDir, "references-dir", "", opts.localizer.MustLocalize("artifact.common.referencesDir"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -135,6 +150,10 @@ func runUpdate(opts *options) error {
 		}
 	}
 
+	if len(opts.references) > 0 || opts.referencesDir != "" {
+		return updateWithReferences(opts, dataAPI, specifiedFile)
+	}
+
 	request := dataAPI.ArtifactsApi.UpdateArtifact(opts.Context, opts.group, opts.artifact)
 	if opts.version != "" {
 		request = request.XRegistryVersion(opts.version)
@@ -156,3 +175,33 @@ func runUpdate(opts *options) error {
 
 	return nil
 }
+
+// updateWithReferences creates the artifact version with the API for artifact references,
+// since the references cannot be sent with the SDK
+func updateWithReferences(opts *options, dataAPI *registryinstanceclient.APIClient, specifiedFile *os.File) error {
+	content, err := ioutil.ReadAll(specifiedFile)
+	if err != nil {
+		return err
+	}
+
+	references, err := util.ResolveReferences(opts.Context, dataAPI, content, opts.references, opts.referencesDir, opts.group)
+	if err != nil {
+		return err
+	}
+
+	request := artifactreference.UpdateArtifactRequest{
+		Version:     opts.version,
+		Name:        opts.name,
+		Description: opts.description,
+		Content:     content,
+		References:  references,
+	}
+
+	if _, _, err = artifactreference.NewAPIClientFromInstance(dataAPI).UpdateArtifact(opts.Context, opts.group, opts.artifact, request); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("artifact.common.message.updatedWithReferences", len(references), localize.NewEntry("Count", len(references))))
+
+	return nil
+}
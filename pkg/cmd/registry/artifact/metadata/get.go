@@ -3,6 +3,7 @@ package metadata
 import (
 	"context"
 
+	"github.com/redhat-developer/app-services-cli/pkg/api/artifactreference"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
@@ -16,6 +17,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/serviceregistryutil"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
 	"github.com/spf13/cobra"
 )
 
@@ -23,6 +25,7 @@ type GetOptions struct {
 	artifact     string
 	group        string
 	outputFormat string
+	references   bool
 
 	registryID string
 
@@ -34,6 +37,13 @@ type GetOptions struct {
 	Context    context.Context
 }
 
+// metadataWithReferences contains the metadata of an artifact with the references of its latest version
+type metadataWithReferences struct {
+	Metadata     registryinstanceclient.ArtifactMetaData `json:"metadata" yaml:"metadata"`
+	References   []artifactreference.ArtifactReference   `json:"references" yaml:"references"`
+	ReferencedBy []artifactreference.ArtifactReference   `json:"referencedBy" yaml:"referencedBy"`
+}
+
 // NewGetMetadataCommand creates a new command for fetching metadata for registry artifacts.
 func NewGetMetadataCommand(f *factory.Factory) *cobra.Command {
 	opts := &GetOptions{
@@ -79,6 +89,7 @@ func NewGetMetadataCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.group, "group", "g", util.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
 	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.instance.id"))
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("artifact.common.message.output.format"))
+	cmd.Flags().BoolVar(&opts.references, "references", false, opts.localizer.MustLocalize("artifact.common.references"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -122,5 +133,18 @@ func runGet(opts *GetOptions) error {
 		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.webURL", localize.NewEntry("URL", color.Info(artifactURL))))
 	}
 
-	return dump.Formatted(opts.IO.Out, opts.outputFormat, response)
+	if !opts.references {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, response)
+	}
+
+	references, err := util.GetArtifactReferences(opts.Context, artifactreference.NewAPIClientFromInstance(dataAPI), response.GetGlobalId())
+	if err != nil {
+		return err
+	}
+
+	return dump.Formatted(opts.IO.Out, opts.outputFormat, metadataWithReferences{
+		Metadata:     response,
+		References:   references.References,
+		ReferencedBy: references.ReferencedBy,
+	})
 }
@@ -0,0 +1,314 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	dir            string
+	layout         string
+	manifest       string
+	group          string
+	registryID     string
+	disableRemoved bool
+	allGroups      bool
+	dryRun         bool
+	skipConfirm    bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewSyncCommand creates a new command to synchronize a local directory of schemas with the registry
+func NewSyncCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "sync",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.sync.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.sync.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.sync.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !flagutil.IsValidInput(opts.layout, util.ValidSyncLayouts...) {
+				return flagutil.InvalidValueError("layout", opts.layout, util.ValidSyncLayouts...)
+			}
+
+			if opts.manifest != "" && cmd.Flags().Changed("layout") {
+				return opts.localizer.MustLocalizeError("artifact.cmd.sync.error.layoutWithManifest")
+			}
+
+			if opts.allGroups && !opts.disableRemoved {
+				return opts.localizer.MustLocalizeError("artifact.cmd.sync.error.allGroupsWithoutDisableRemoved")
+			}
+
+			if !opts.IO.CanPrompt() && !opts.skipConfirm && !opts.dryRun {
+				return flagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if opts.registryID != "" {
+				return runSync(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetServiceRegistryIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("artifact.cmd.common.error.noServiceRegistrySelected")
+			}
+
+			opts.registryID = instanceID
+			return runSync(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.dir, "dir", "", opts.localizer.MustLocalize("artifact.cmd.sync.flag.dir.description"))
+	cmd.Flags().StringVar(&opts.layout, "layout", util.SyncLayoutGroupDir, flagutil.FlagDescription(opts.localizer, "artifact.cmd.sync.flag.layout.description", append([]string{}, util.ValidSyncLayouts...)...))
+	cmd.Flags().StringVar(&opts.manifest, "manifest", "", opts.localizer.MustLocalize("artifact.cmd.sync.flag.manifest.description"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", util.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.cmd.sync.flag.group.description"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.instance.id"))
+	cmd.Flags().BoolVar(&opts.disableRemoved, "disable-removed", false, opts.localizer.MustLocalize("artifact.cmd.sync.flag.disableRemoved.description"))
+	cmd.Flags().BoolVar(&opts.allGroups, "all-groups", false, opts.localizer.MustLocalize("artifact.cmd.sync.flag.allGroups.description"))
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("artifact.cmd.sync.flag.dryRun.description"))
+	cmd.Flags().BoolVarP(&opts.skipConfirm, "yes", "y", false, opts.localizer.MustLocalize("artifact.cmd.sync.flag.yes.description"))
+
+	_ = cmd.MarkFlagRequired("dir")
+
+	flagutil.EnableStaticFlagCompletion(cmd, "layout", util.ValidSyncLayouts)
+
+	return cmd
+}
+
+// nolint:funlen
+func runSync(opts *options) error {
+	var local []util.LocalArtifact
+	var err error
+	if opts.manifest != "" {
+		local, err = util.ReadSyncManifest(opts.dir, opts.manifest, opts.group)
+	} else {
+		local, err = util.ScanDirectory(opts.dir, opts.layout, opts.group)
+	}
+	if err != nil {
+		return err
+	}
+
+	dirEntry := localize.NewEntry("Dir", opts.dir)
+
+	if len(local) == 0 {
+		return opts.localizer.MustLocalizeError("artifact.cmd.sync.error.noArtifacts", dirEntry)
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	remote, err := listRemoteArtifacts(opts, dataAPI, local)
+	if err != nil {
+		return registrycmdutil.TransformInstanceError(err)
+	}
+
+	changes := util.PlanSync(local, remote, opts.disableRemoved, opts.allGroups)
+
+	if len(changes) == 0 {
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.sync.log.info.inSync", dirEntry))
+		return nil
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("artifact.cmd.sync.log.info.plan", len(changes),
+		localize.NewEntry("Count", len(changes)),
+		dirEntry,
+	))
+	opts.Logger.Info("")
+	dump.Table(opts.IO.Out, util.MapSyncChangesToTableRows(changes))
+	opts.Logger.Info("")
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.skipConfirm {
+		prompt := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("artifact.cmd.sync.input.confirmSyncMessage"),
+		}
+		if err = survey.AskOne(prompt, &opts.skipConfirm); err != nil {
+			return err
+		}
+
+		if !opts.skipConfirm {
+			opts.Logger.Debug(opts.localizer.MustLocalize("artifact.cmd.sync.log.debug.syncNotConfirmed"))
+			return nil
+		}
+	}
+
+	spinnr := spinner.New(opts.IO.ErrOut, opts.localizer)
+	spinnr.SetLocalizedSuffix("artifact.cmd.sync.log.info.syncing", dirEntry)
+	spinnr.Start()
+
+	counts := map[string]int{}
+	for _, change := range changes {
+		if err = applyChange(opts, dataAPI, change); err != nil {
+			spinnr.Stop()
+			return registrycmdutil.TransformInstanceError(err)
+		}
+		counts[change.Action]++
+	}
+	spinnr.Stop()
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.sync.log.info.synced",
+		localize.NewEntry("Created", counts[util.SyncActionCreate]),
+		localize.NewEntry("Updated", counts[util.SyncActionUpdate]),
+		localize.NewEntry("Enabled", counts[util.SyncActionEnable]),
+		localize.NewEntry("Disabled", counts[util.SyncActionDisable]),
+		dirEntry,
+	))
+
+	return nil
+}
+
+// listRemoteArtifacts fetches the artifacts of the groups of the local artifacts, or of all groups
+// when removed artifacts are disabled in all groups, and checks the content of the artifacts which are also local
+func listRemoteArtifacts(opts *options, api *registryinstanceclient.APIClient, local []util.LocalArtifact) ([]util.RemoteArtifact, error) {
+	localFiles := map[string]map[string]string{}
+	for _, l := range local {
+		if localFiles[l.Group] == nil {
+			localFiles[l.Group] = map[string]string{}
+		}
+		localFiles[l.Group][l.ArtifactID] = l.File
+	}
+
+	// artifacts of groups which have no local files are only disabled with "--all-groups"
+	groups := []string{""}
+	if !opts.disableRemoved || !opts.allGroups {
+		groups = make([]string, 0, len(localFiles))
+		for group := range localFiles {
+			groups = append(groups, group)
+		}
+		sort.Strings(groups)
+	}
+
+	var remote []util.RemoteArtifact
+	for _, group := range groups {
+		artifacts, err := util.ListAllArtifacts(opts.Context, api, group)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range artifacts {
+			r := util.RemoteArtifact{Group: a.GetGroupId(), ArtifactID: a.GetId(), State: string(a.GetState())}
+			if r.Group == "" {
+				r.Group = util.DefaultArtifactGroup
+			}
+
+			file, ok := localFiles[r.Group][r.ArtifactID]
+			if ok && a.GetState() != registryinstanceclient.ARTIFACTSTATE_DISABLED {
+				inSync, err := util.LatestVersionHasContent(opts.Context, api, r.Group, r.ArtifactID, file)
+				if err != nil {
+					return nil, err
+				}
+				r.ContentChanged = !inSync
+			}
+
+			remote = append(remote, r)
+		}
+	}
+
+	return remote, nil
+}
+
+func applyChange(opts *options, api *registryinstanceclient.APIClient, change util.SyncChange) error {
+	opts.Logger.Debug(opts.localizer.MustLocalize("artifact.cmd.sync.log.debug.applyingChange",
+		localize.NewEntry("Action", change.Action),
+		localize.NewEntry("Group", change.Group),
+		localize.NewEntry("ArtifactID", change.ArtifactID),
+	))
+
+	switch change.Action {
+	case util.SyncActionCreate:
+		file, err := os.Open(change.File)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		request := api.ArtifactsApi.CreateArtifact(opts.Context, change.Group).XRegistryArtifactId(change.ArtifactID)
+		if change.Type != "" {
+			request = request.XRegistryArtifactType(registryinstanceclient.ArtifactType(change.Type))
+		}
+		_, _, err = request.Body(file).Execute()
+		return err
+	case util.SyncActionUpdate:
+		return updateContent(opts, api, change)
+	case util.SyncActionEnable:
+		if err := setState(opts, api, change, registryinstanceclient.ARTIFACTSTATE_ENABLED); err != nil {
+			return err
+		}
+
+		// the content of disabled artifacts is not compared when planning
+		inSync, err := util.LatestVersionHasContent(opts.Context, api, change.Group, change.ArtifactID, change.File)
+		if err != nil || inSync {
+			return err
+		}
+		return updateContent(opts, api, change)
+	case util.SyncActionDisable:
+		return setState(opts, api, change, registryinstanceclient.ARTIFACTSTATE_DISABLED)
+	}
+
+	return nil
+}
+
+func updateContent(opts *options, api *registryinstanceclient.APIClient, change util.SyncChange) error {
+	file, err := os.Open(change.File)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, _, err = api.ArtifactsApi.UpdateArtifact(opts.Context, change.Group, change.ArtifactID).Body(file).Execute()
+	return err
+}
+
+func setState(opts *options, api *registryinstanceclient.APIClient, change util.SyncChange, state registryinstanceclient.ArtifactState) error {
+	_, err := api.ArtifactsApi.UpdateArtifactState(opts.Context, change.Group, change.ArtifactID).
+		UpdateState(*registryinstanceclient.NewUpdateState(state)).
+		Execute()
+	return err
+}
@@ -0,0 +1,182 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/artifactreference"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/rulecmdutil"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+// protobufImportRegexp matches the import statements of a Protobuf schema
+var protobufImportRegexp = regexp.MustCompile(`(?m)^\s*import\s+(?:public\s+|weak\s+)?"([^"]+)"\s*;`)
+
+// protobufWellKnownPrefix is the prefix of the imports of well-known types, which are not artifacts
+const protobufWellKnownPrefix = "google/protobuf/"
+
+// ParseReference parses an artifact reference in the name=group:artifact-id:version format.
+// When the group is empty, the default group is used
+func ParseReference(value string) (*artifactreference.ArtifactReference, error) {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, fmt.Errorf("invalid reference %q: expected format is name=group:artifact-id:version", value)
+	}
+
+	target := strings.Split(parts[1], ":")
+	if len(target) != 3 || target[1] == "" || target[2] == "" {
+		return nil, fmt.Errorf("invalid reference %q: expected format is name=group:artifact-id:version", value)
+	}
+
+	group := target[0]
+	if group == "" {
+		group = DefaultArtifactGroup
+	}
+
+	return &artifactreference.ArtifactReference{
+		Name:       parts[0],
+		GroupID:    group,
+		ArtifactID: target[1],
+		Version:    target[2],
+	}, nil
+}
+
+// ProtobufImports returns the files imported by a Protobuf schema, except the well-known types
+func ProtobufImports(content []byte) []string {
+	var imports []string
+	for _, match := range protobufImportRegexp.FindAllSubmatch(content, -1) {
+		file := string(match[1])
+		if !strings.HasPrefix(file, protobufWellKnownPrefix) {
+			imports = append(imports, file)
+		}
+	}
+	return imports
+}
+
+// DiscoverProtobufReferences maps the imports of a Protobuf schema to the files of a directory,
+// and the files to artifacts using the group-dir layout of "artifact sync".
+// The referenced version is the version of the artifact which has the content of the file
+func DiscoverProtobufReferences(ctx context.Context, api *registryinstanceclient.APIClient, content []byte, dir string, defaultGroup string) ([]artifactreference.ArtifactReference, error) {
+	var references []artifactreference.ArtifactReference
+	for _, imported := range ProtobufImports(content) {
+		path := filepath.Join(dir, filepath.FromSlash(imported))
+
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("import %q: %w", imported, err)
+		}
+
+		group, artifactID := mapFileToArtifact(filepath.FromSlash(imported), SyncLayoutGroupDir, defaultGroup)
+		metadata, _, err := api.MetadataApi.GetArtifactVersionMetaDataByContent(ctx, group, artifactID).Body(file).Execute()
+		file.Close()
+		if err != nil {
+			if rulecmdutil.IsNotFoundError(err) {
+				return nil, fmt.Errorf("import %q: no version of artifact %q in group %q has the content of %v", imported, artifactID, group, path)
+			}
+			return nil, fmt.Errorf("import %q: %w", imported, registrycmdutil.TransformInstanceError(err))
+		}
+
+		references = append(references, artifactreference.ArtifactReference{
+			Name:       imported,
+			GroupID:    group,
+			ArtifactID: artifactID,
+			Version:    metadata.GetVersion(),
+		})
+	}
+
+	return references, nil
+}
+
+// ResolveReferences combines the references of the "--reference" flag values
+// with the references discovered from the Protobuf imports, when a directory is specified
+func ResolveReferences(ctx context.Context, api *registryinstanceclient.APIClient, content []byte, values []string, dir string, defaultGroup string) ([]artifactreference.ArtifactReference, error) {
+	explicit := make([]artifactreference.ArtifactReference, 0, len(values))
+	for _, value := range values {
+		reference, err := ParseReference(value)
+		if err != nil {
+			return nil, err
+		}
+		explicit = append(explicit, *reference)
+	}
+
+	if dir == "" {
+		return explicit, nil
+	}
+
+	discovered, err := DiscoverProtobufReferences(ctx, api, content, dir, defaultGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return MergeReferences(discovered, explicit), nil
+}
+
+// MergeReferences adds the explicit references to the discovered references,
+// replacing the discovered references with the same name
+func MergeReferences(discovered []artifactreference.ArtifactReference, explicit []artifactreference.ArtifactReference) []artifactreference.ArtifactReference {
+	names := map[string]bool{}
+	for _, r := range explicit {
+		names[r.Name] = true
+	}
+
+	merged := make([]artifactreference.ArtifactReference, 0, len(discovered)+len(explicit))
+	for _, r := range discovered {
+		if !names[r.Name] {
+			merged = append(merged, r)
+		}
+	}
+
+	return append(merged, explicit...)
+}
+
+// Relations between an artifact version and the artifacts of its references
+const (
+	ReferenceRelationReferences   = "references"
+	ReferenceRelationReferencedBy = "referenced-by"
+)
+
+// ArtifactReferences contains the references of an artifact version in both directions
+type ArtifactReferences struct {
+	References   []artifactreference.ArtifactReference `json:"references" yaml:"references"`
+	ReferencedBy []artifactreference.ArtifactReference `json:"referencedBy" yaml:"referencedBy"`
+}
+
+type referenceRow struct {
+	Relation   string `header:"Relation"`
+	Name       string `header:"Name"`
+	Group      string `header:"Group"`
+	ArtifactID string `header:"Artifact ID"`
+	Version    string `header:"Version"`
+}
+
+// GetArtifactReferences fetches the artifacts referenced by an artifact version, and the artifacts which reference it
+func GetArtifactReferences(ctx context.Context, api artifactreference.API, globalID int64) (*ArtifactReferences, error) {
+	outbound, _, err := api.GetReferencesByGlobalID(ctx, globalID, artifactreference.ReferenceTypeOutbound)
+	if err != nil {
+		return nil, err
+	}
+
+	inbound, _, err := api.GetReferencesByGlobalID(ctx, globalID, artifactreference.ReferenceTypeInbound)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtifactReferences{References: outbound, ReferencedBy: inbound}, nil
+}
+
+// MapReferencesToTableRows converts the references of an artifact version into a formatted table for printing
+func MapReferencesToTableRows(references *ArtifactReferences) []referenceRow {
+	rows := make([]referenceRow, 0, len(references.References)+len(references.ReferencedBy))
+	for _, r := range references.References {
+		rows = append(rows, referenceRow{ReferenceRelationReferences, r.Name, r.GroupID, r.ArtifactID, r.Version})
+	}
+	for _, r := range references.ReferencedBy {
+		rows = append(rows, referenceRow{ReferenceRelationReferencedBy, r.Name, r.GroupID, r.ArtifactID, r.Version})
+	}
+	return rows
+}
@@ -0,0 +1,87 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/artifactreference"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    *artifactreference.ArtifactReference
+		wantErr bool
+	}{
+		{
+			name:  "Should parse a reference",
+			value: "common/money.proto=common:money:2",
+			want:  &artifactreference.ArtifactReference{Name: "common/money.proto", GroupID: "common", ArtifactID: "money", Version: "2"},
+		},
+		{
+			name:  "Should use the default group when the group is empty",
+			value: "com.acme.Address=:address:1.0.0",
+			want:  &artifactreference.ArtifactReference{Name: "com.acme.Address", GroupID: DefaultArtifactGroup, ArtifactID: "address", Version: "1.0.0"},
+		},
+		{
+			name:    "Should reject a reference without a name",
+			value:   "common:money:2",
+			wantErr: true,
+		},
+		{
+			name:    "Should reject a reference without a version",
+			value:   "money.proto=common:money",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReference(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseReference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProtobufImports(t *testing.T) {
+	content := []byte(`syntax = "proto3";
+package shop;
+
+import "google/protobuf/timestamp.proto";
+import "common/money.proto";
+import public "shop/customer.proto";
+
+// import "commented/out.proto";
+message Order {
+  common.Money amount = 1;
+}`)
+
+	want := []string{"common/money.proto", "shop/customer.proto"}
+	if got := ProtobufImports(content); !reflect.DeepEqual(got, want) {
+		t.Errorf("ProtobufImports() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeReferences(t *testing.T) {
+	discovered := []artifactreference.ArtifactReference{
+		{Name: "common/money.proto", GroupID: "common", ArtifactID: "money", Version: "2"},
+		{Name: "shop/customer.proto", GroupID: "shop", ArtifactID: "customer", Version: "1"},
+	}
+	explicit := []artifactreference.ArtifactReference{
+		{Name: "common/money.proto", GroupID: "common", ArtifactID: "money", Version: "3"},
+	}
+
+	want := []artifactreference.ArtifactReference{
+		{Name: "shop/customer.proto", GroupID: "shop", ArtifactID: "customer", Version: "1"},
+		{Name: "common/money.proto", GroupID: "common", ArtifactID: "money", Version: "3"},
+	}
+	if got := MergeReferences(discovered, explicit); !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeReferences() = %v, want %v", got, want)
+	}
+}
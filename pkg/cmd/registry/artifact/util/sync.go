@@ -0,0 +1,342 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/rulecmdutil"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+	"gopkg.in/yaml.v2"
+)
+
+const searchPageSize = 100
+
+// Layouts which map the files of a directory to artifacts
+const (
+	// SyncLayoutGroupDir maps <group>/<artifact-id>.<ext> files, where nested directories are joined with dots
+	SyncLayoutGroupDir = "group-dir"
+	// SyncLayoutFlat maps all <artifact-id>.<ext> files to the same group
+	SyncLayoutFlat = "flat"
+)
+
+var ValidSyncLayouts = []string{SyncLayoutGroupDir, SyncLayoutFlat}
+
+// Actions of an artifact sync plan
+const (
+	SyncActionCreate  = "create"
+	SyncActionUpdate  = "update"
+	SyncActionEnable  = "enable"
+	SyncActionDisable = "disable"
+)
+
+// artifactTypeExtensions maps file extensions to the artifact types
+var artifactTypeExtensions = map[string]string{
+	".avsc":     "AVRO",
+	".avro":     "AVRO",
+	".proto":    "PROTOBUF",
+	".json":     "JSON",
+	".graphql":  "GRAPHQL",
+	".graphqls": "GRAPHQL",
+	".wsdl":     "WSDL",
+	".xsd":      "XSD",
+	".xml":      "XML",
+}
+
+// LocalArtifact is a file of a directory which is mapped to an artifact
+type LocalArtifact struct {
+	Group      string
+	ArtifactID string
+	Type       string
+	File       string
+}
+
+// RemoteArtifact is the current state of an artifact in the registry
+type RemoteArtifact struct {
+	Group      string
+	ArtifactID string
+	State      string
+	// ContentChanged is set when the latest version does not have the content of the local file
+	ContentChanged bool
+}
+
+// SyncManifest maps the files of a directory to artifacts explicitly
+type SyncManifest struct {
+	Artifacts []SyncManifestEntry `json:"artifacts" yaml:"artifacts"`
+}
+
+// SyncManifestEntry maps a file, relative to the directory, to an artifact.
+// The group, artifact ID and type are optional
+type SyncManifestEntry struct {
+	File       string `json:"file" yaml:"file"`
+	Group      string `json:"group,omitempty" yaml:"group,omitempty"`
+	ArtifactID string `json:"artifactId,omitempty" yaml:"artifactId,omitempty"`
+	Type       string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// SyncChange is an artifact which is changed by a sync
+type SyncChange struct {
+	Action     string
+	Group      string
+	ArtifactID string
+	Type       string
+	File       string
+}
+
+type syncChangeRow struct {
+	Action     string `header:"Action"`
+	Group      string `header:"Group"`
+	ArtifactID string `header:"Artifact ID"`
+	Type       string `header:"Type"`
+	File       string `header:"File"`
+}
+
+// ArtifactTypeFromExtension infers the artifact type from the extension of a file, or returns an empty string
+func ArtifactTypeFromExtension(file string) string {
+	return artifactTypeExtensions[strings.ToLower(filepath.Ext(file))]
+}
+
+// ScanDirectory maps the files of a directory to artifacts using the layout.
+// Hidden files and files with an unknown extension are ignored
+func ScanDirectory(dir string, layout string, defaultGroup string) ([]LocalArtifact, error) {
+	var artifacts []LocalArtifact
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		artifactType := ArtifactTypeFromExtension(path)
+		if d.IsDir() || artifactType == "" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		group, artifactID := mapFileToArtifact(rel, layout, defaultGroup)
+		artifacts = append(artifacts, LocalArtifact{Group: group, ArtifactID: artifactID, Type: artifactType, File: path})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return artifacts, checkDuplicateArtifacts(artifacts)
+}
+
+// ReadSyncManifest maps the files of a directory to artifacts using a JSON or YAML manifest
+func ReadSyncManifest(dir string, manifestFile string, defaultGroup string) ([]LocalArtifact, error) {
+	data, err := ioutil.ReadFile(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest SyncManifest
+	if err = yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %v: %w", manifestFile, err)
+	}
+
+	artifacts := make([]LocalArtifact, 0, len(manifest.Artifacts))
+	for i, entry := range manifest.Artifacts {
+		if entry.File == "" {
+			return nil, fmt.Errorf("invalid manifest %v: artifact %v has no file", manifestFile, i+1)
+		}
+
+		group := entry.Group
+		if group == "" {
+			group = defaultGroup
+		}
+
+		artifactID := entry.ArtifactID
+		if artifactID == "" {
+			artifactID = artifactIDFromFile(entry.File)
+		}
+
+		artifactType := strings.ToUpper(entry.Type)
+		if artifactType == "" {
+			artifactType = ArtifactTypeFromExtension(entry.File)
+		}
+		if artifactType != "" && !containsString(AllowedArtifactTypeEnumValues, artifactType) {
+			return nil, fmt.Errorf("invalid manifest %v: artifact %v has an invalid type %q", manifestFile, i+1, entry.Type)
+		}
+
+		path := filepath.Join(dir, entry.File)
+		if _, err = os.Stat(path); err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, LocalArtifact{Group: group, ArtifactID: artifactID, Type: artifactType, File: path})
+	}
+
+	return artifacts, checkDuplicateArtifacts(artifacts)
+}
+
+// ListAllArtifacts fetches all artifacts of a group, or of all groups when the group is empty
+func ListAllArtifacts(ctx context.Context, api *registryinstanceclient.APIClient, group string) ([]registryinstanceclient.SearchedArtifact, error) {
+	var artifacts []registryinstanceclient.SearchedArtifact
+	for offset := int32(0); ; offset += searchPageSize {
+		request := api.ArtifactsApi.SearchArtifacts(ctx).
+			Offset(offset).
+			Limit(searchPageSize).
+			Orderby(registryinstanceclient.SORTBY_CREATED_ON).
+			Order(registryinstanceclient.SORTORDER_ASC)
+		if group != "" {
+			request = request.Group(group)
+		}
+
+		results, _, err := request.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		items := results.GetArtifacts()
+		artifacts = append(artifacts, items...)
+
+		if len(items) == 0 || len(artifacts) >= int(results.GetCount()) {
+			return artifacts, nil
+		}
+	}
+}
+
+// LatestVersionHasContent checks if the latest version of an artifact has the content of a file.
+// The registry looks the version up by the hash of the content, so the content of the artifact is not downloaded
+func LatestVersionHasContent(ctx context.Context, api *registryinstanceclient.APIClient, group string, artifactID string, path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	version, _, err := api.MetadataApi.GetArtifactVersionMetaDataByContent(ctx, group, artifactID).Body(file).Execute()
+	if err != nil {
+		if rulecmdutil.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	latest, _, err := api.MetadataApi.GetArtifactMetaData(ctx, group, artifactID).Execute()
+	if err != nil {
+		return false, err
+	}
+
+	return latest.GetContentId() == version.GetContentId(), nil
+}
+
+// PlanSync computes the changes which make the artifacts of the registry match the local artifacts.
+// Artifacts are updated only when their latest version does not have the local content.
+// When disableRemoved is set, the enabled remote artifacts which have no local file are disabled
+// in the groups of the local artifacts, or in all groups when allGroups is set
+func PlanSync(local []LocalArtifact, remote []RemoteArtifact, disableRemoved bool, allGroups bool) []SyncChange {
+	remoteByKey := map[string]RemoteArtifact{}
+	for _, r := range remote {
+		remoteByKey[artifactKey(r.Group, r.ArtifactID)] = r
+	}
+
+	localKeys := map[string]bool{}
+	localGroups := map[string]bool{}
+	var changes []SyncChange
+	for _, l := range local {
+		key := artifactKey(l.Group, l.ArtifactID)
+		localKeys[key] = true
+		localGroups[l.Group] = true
+
+		change := SyncChange{Group: l.Group, ArtifactID: l.ArtifactID, Type: l.Type, File: l.File}
+
+		r, ok := remoteByKey[key]
+		switch {
+		case !ok:
+			change.Action = SyncActionCreate
+		case r.State == string(registryinstanceclient.ARTIFACTSTATE_DISABLED):
+			change.Action = SyncActionEnable
+		case r.ContentChanged:
+			change.Action = SyncActionUpdate
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	if disableRemoved {
+		for _, r := range remote {
+			if localKeys[artifactKey(r.Group, r.ArtifactID)] || r.State == string(registryinstanceclient.ARTIFACTSTATE_DISABLED) {
+				continue
+			}
+			if !allGroups && !localGroups[r.Group] {
+				continue
+			}
+			changes = append(changes, SyncChange{Action: SyncActionDisable, Group: r.Group, ArtifactID: r.ArtifactID})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Group != changes[j].Group {
+			return changes[i].Group < changes[j].Group
+		}
+		return changes[i].ArtifactID < changes[j].ArtifactID
+	})
+
+	return changes
+}
+
+// MapSyncChangesToTableRows converts the changes of an artifact sync into a formatted table for printing
+func MapSyncChangesToTableRows(changes []SyncChange) []syncChangeRow {
+	rows := make([]syncChangeRow, len(changes))
+	for i, c := range changes {
+		rows[i] = syncChangeRow(c)
+	}
+	return rows
+}
+
+// mapFileToArtifact returns the group and artifact ID of a file, relative to the directory, using the layout
+func mapFileToArtifact(rel string, layout string, defaultGroup string) (group string, artifactID string) {
+	group = defaultGroup
+	if relDir := filepath.Dir(rel); layout == SyncLayoutGroupDir && relDir != "." {
+		group = strings.ReplaceAll(filepath.ToSlash(relDir), "/", ".")
+	}
+	return group, artifactIDFromFile(rel)
+}
+
+func artifactIDFromFile(file string) string {
+	base := filepath.Base(file)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+func checkDuplicateArtifacts(artifacts []LocalArtifact) error {
+	files := map[string]string{}
+	for _, a := range artifacts {
+		key := artifactKey(a.Group, a.ArtifactID)
+		if file, ok := files[key]; ok {
+			return fmt.Errorf("files %v and %v are both mapped to artifact %q in group %q", file, a.File, a.ArtifactID, a.Group)
+		}
+		files[key] = a.File
+	}
+	return nil
+}
+
+func artifactKey(group string, artifactID string) string {
+	return group + "/" + artifactID
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
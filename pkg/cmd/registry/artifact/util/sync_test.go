@@ -0,0 +1,181 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestScanDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"orders/order.avsc":          `{"type": "string"}`,
+		"com/acme/customer.proto":    `syntax = "proto3";`,
+		"address.json":               `{}`,
+		"README.md":                  "# Schemas",
+		".git/config.json":           `{}`,
+		"orders/.order-draft.avsc":   `{"type": "int"}`,
+		"com/acme/customer-old.json": `{}`,
+	})
+
+	tests := []struct {
+		name   string
+		layout string
+		want   map[string]string
+	}{
+		{
+			name:   "Should map directories to groups",
+			layout: SyncLayoutGroupDir,
+			want: map[string]string{
+				"default/address":       "JSON",
+				"com.acme/customer":     "PROTOBUF",
+				"com.acme/customer-old": "JSON",
+				"orders/order":          "AVRO",
+			},
+		},
+		{
+			name:   "Should map all files to the default group",
+			layout: SyncLayoutFlat,
+			want: map[string]string{
+				"default/address":      "JSON",
+				"default/customer":     "PROTOBUF",
+				"default/customer-old": "JSON",
+				"default/order":        "AVRO",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artifacts, err := ScanDirectory(dir, tt.layout, DefaultArtifactGroup)
+			if err != nil {
+				t.Fatalf("ScanDirectory() error = %v", err)
+			}
+
+			got := map[string]string{}
+			for _, a := range artifacts {
+				got[artifactKey(a.Group, a.ArtifactID)] = a.Type
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanDirectory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanDirectoryDuplicates(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"orders/order.avsc": `{"type": "string"}`,
+		"order.json":        `{}`,
+	})
+
+	if _, err := ScanDirectory(dir, SyncLayoutFlat, DefaultArtifactGroup); err == nil {
+		t.Error("ScanDirectory() expected an error for files mapped to the same artifact")
+	}
+}
+
+func TestReadSyncManifest(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"v1/order.avsc": `{"type": "string"}`,
+		"openapi.yaml":  `openapi: 3.0.0`,
+		"manifest.yaml": `
+artifacts:
+  - file: v1/order.avsc
+    group: orders
+  - file: openapi.yaml
+    artifactId: orders-api
+    type: openapi
+`,
+	})
+
+	artifacts, err := ReadSyncManifest(dir, filepath.Join(dir, "manifest.yaml"), DefaultArtifactGroup)
+	if err != nil {
+		t.Fatalf("ReadSyncManifest() error = %v", err)
+	}
+
+	want := []LocalArtifact{
+		{Group: "orders", ArtifactID: "order", Type: "AVRO", File: filepath.Join(dir, "v1/order.avsc")},
+		{Group: DefaultArtifactGroup, ArtifactID: "orders-api", Type: "OPENAPI", File: filepath.Join(dir, "openapi.yaml")},
+	}
+	if !reflect.DeepEqual(artifacts, want) {
+		t.Errorf("ReadSyncManifest() = %v, want %v", artifacts, want)
+	}
+}
+
+func TestPlanSync(t *testing.T) {
+	local := []LocalArtifact{
+		{Group: "orders", ArtifactID: "order", Type: "AVRO", File: "orders/order.avsc"},
+		{Group: "orders", ArtifactID: "invoice", Type: "AVRO", File: "orders/invoice.avsc"},
+		{Group: "orders", ArtifactID: "payment", Type: "AVRO", File: "orders/payment.avsc"},
+		{Group: "orders", ArtifactID: "refund", Type: "AVRO", File: "orders/refund.avsc"},
+	}
+	remote := []RemoteArtifact{
+		{Group: "orders", ArtifactID: "order", State: "ENABLED"},
+		{Group: "orders", ArtifactID: "invoice", State: "ENABLED", ContentChanged: true},
+		{Group: "orders", ArtifactID: "refund", State: "DISABLED"},
+		{Group: "orders", ArtifactID: "legacy", State: "DEPRECATED"},
+		{Group: "orders", ArtifactID: "archived", State: "DISABLED"},
+		{Group: "payments", ArtifactID: "card", State: "ENABLED"},
+	}
+
+	tests := []struct {
+		name           string
+		disableRemoved bool
+		allGroups      bool
+		want           []SyncChange
+	}{
+		{
+			name: "Should create, update and enable artifacts",
+			want: []SyncChange{
+				{Action: SyncActionUpdate, Group: "orders", ArtifactID: "invoice", Type: "AVRO", File: "orders/invoice.avsc"},
+				{Action: SyncActionCreate, Group: "orders", ArtifactID: "payment", Type: "AVRO", File: "orders/payment.avsc"},
+				{Action: SyncActionEnable, Group: "orders", ArtifactID: "refund", Type: "AVRO", File: "orders/refund.avsc"},
+			},
+		},
+		{
+			name:           "Should disable removed artifacts of the local groups only",
+			disableRemoved: true,
+			want: []SyncChange{
+				{Action: SyncActionUpdate, Group: "orders", ArtifactID: "invoice", Type: "AVRO", File: "orders/invoice.avsc"},
+				{Action: SyncActionDisable, Group: "orders", ArtifactID: "legacy"},
+				{Action: SyncActionCreate, Group: "orders", ArtifactID: "payment", Type: "AVRO", File: "orders/payment.avsc"},
+				{Action: SyncActionEnable, Group: "orders", ArtifactID: "refund", Type: "AVRO", File: "orders/refund.avsc"},
+			},
+		},
+		{
+			name:           "Should disable removed artifacts of all groups",
+			disableRemoved: true,
+			allGroups:      true,
+			want: []SyncChange{
+				{Action: SyncActionUpdate, Group: "orders", ArtifactID: "invoice", Type: "AVRO", File: "orders/invoice.avsc"},
+				{Action: SyncActionDisable, Group: "orders", ArtifactID: "legacy"},
+				{Action: SyncActionCreate, Group: "orders", ArtifactID: "payment", Type: "AVRO", File: "orders/payment.avsc"},
+				{Action: SyncActionEnable, Group: "orders", ArtifactID: "refund", Type: "AVRO", File: "orders/refund.avsc"},
+				{Action: SyncActionDisable, Group: "payments", ArtifactID: "card"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlanSync(local, remote, tt.disableRemoved, tt.allGroups); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlanSync() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
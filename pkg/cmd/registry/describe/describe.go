@@ -2,14 +2,19 @@ package describe
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccountutil/credentials"
 	"github.com/redhat-developer/app-services-cli/pkg/serviceregistryutil"
 	srsmgmtv1 "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
 	"github.com/spf13/cobra"
@@ -20,13 +25,25 @@ type options struct {
 	name         string
 	outputFormat string
 
+	endpoints       bool
+	credentialsFile string
+	snippetType     string
+
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
 	Connection factory.ConnectionFunc
+	Logger     logging.Logger
 	localizer  localize.Localizer
 	Context    context.Context
 }
 
+// endpointRow contains the properties used to
+// populate the endpoints of a registry into a table row
+type endpointRow struct {
+	Name string `json:"name" header:"Endpoint"`
+	URL  string `json:"url" header:"URL"`
+}
+
 // NewDescribeCommand describes a service instance, either by passing an `--id flag`
 // or by using the service instance set in the config, if any
 func NewDescribeCommand(f *factory.Factory) *cobra.Command {
@@ -34,6 +51,7 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 		Config:     f.Config,
 		Connection: f.Connection,
 		IO:         f.IOStreams,
+		Logger:     f.Logger,
 		localizer:  f.Localizer,
 		Context:    f.Context,
 	}
@@ -54,6 +72,15 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 				return opts.localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
 			}
 
+			if opts.snippetType != "" && !flagutil.IsValidInput(opts.snippetType, registrycmdutil.ValidSnippetTypes...) {
+				return flagutil.InvalidValueError("snippet", opts.snippetType, registrycmdutil.ValidSnippetTypes...)
+			}
+
+			// the snippet flags only apply to the endpoints view
+			if opts.credentialsFile != "" || opts.snippetType != "" {
+				opts.endpoints = true
+			}
+
 			if opts.id != "" || opts.name != "" {
 				return runDescribe(opts)
 			}
@@ -77,8 +104,12 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("registry.cmd.describe.flag.name.description"))
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("registry.cmd.flag.output.description"))
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("registry.describe.flag.id"))
+	cmd.Flags().BoolVar(&opts.endpoints, "endpoints", false, opts.localizer.MustLocalize("registry.describe.flag.endpoints"))
+	cmd.Flags().StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("registry.describe.flag.credentialsFile"))
+	cmd.Flags().StringVar(&opts.snippetType, "snippet", "", flagutil.FlagDescription(opts.localizer, "registry.describe.flag.snippet", registrycmdutil.ValidSnippetTypes...))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
+	flagutil.EnableStaticFlagCompletion(cmd, "snippet", registrycmdutil.ValidSnippetTypes)
 
 	return cmd
 }
@@ -104,5 +135,80 @@ func runDescribe(opts *options) error {
 		}
 	}
 
+	if opts.endpoints {
+		return printEndpoints(opts, registry)
+	}
+
 	return dump.Formatted(opts.IO.Out, opts.outputFormat, registry)
 }
+
+// printEndpoints prints the URLs of the registry and client configuration snippets
+// with the credentials of a service account
+func printEndpoints(opts *options, registry *srsmgmtv1.Registry) error {
+	endpoints := registrycmdutil.GetEndpoints(registry)
+	if endpoints.RegistryURL == "" {
+		opts.Logger.Info(opts.localizer.MustLocalize("registry.describe.log.info.endpointsNotAvailable", localize.NewEntry("Name", registry.GetName())))
+		return nil
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	snippetConfig := &registrycmdutil.SnippetConfig{
+		Endpoints:    endpoints,
+		ClientID:     "<client-id>",
+		ClientSecret: "<client-secret>",
+		TokenURL:     registrycmdutil.TokenURL(cfg.MasAuthURL),
+	}
+
+	if opts.credentialsFile != "" {
+		creds, err := credentials.Read(opts.credentialsFile)
+		if err != nil {
+			return opts.localizer.MustLocalizeError("registry.describe.error.couldNotReadCredentials", localize.NewEntry("ErrorMessage", err))
+		}
+		snippetConfig.ClientID = creds.ClientID
+		snippetConfig.ClientSecret = creds.ClientSecret
+		if cfg.MasAuthURL == "" && creds.TokenURL != "" {
+			snippetConfig.TokenURL = creds.TokenURL
+		}
+	}
+
+	rows := []endpointRow{
+		{Name: opts.localizer.MustLocalize("registry.describe.endpoint.registry"), URL: endpoints.RegistryURL},
+		{Name: opts.localizer.MustLocalize("registry.describe.endpoint.coreAPI"), URL: endpoints.CoreAPIURL},
+		{Name: opts.localizer.MustLocalize("registry.describe.endpoint.ccompatAPI"), URL: endpoints.CcompatURL},
+	}
+	if endpoints.ConsoleURL != "" {
+		rows = append(rows, endpointRow{Name: opts.localizer.MustLocalize("registry.describe.endpoint.console"), URL: endpoints.ConsoleURL})
+	}
+
+	fmt.Fprintln(opts.IO.Out, color.Bold(opts.localizer.MustLocalize("registry.describe.heading.endpoints", localize.NewEntry("Name", registry.GetName()))))
+	fmt.Fprintln(opts.IO.Out)
+	dump.Table(opts.IO.Out, rows)
+
+	snippetTypes := registrycmdutil.ValidSnippetTypes
+	if opts.snippetType != "" {
+		snippetTypes = []string{opts.snippetType}
+	}
+
+	for _, snippetType := range snippetTypes {
+		snippet, err := registrycmdutil.RenderSnippet(snippetType, snippetConfig)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(opts.IO.Out)
+		fmt.Fprintln(opts.IO.Out, color.Bold(opts.localizer.MustLocalize("registry.describe.heading.snippet."+snippetType)))
+		fmt.Fprintln(opts.IO.Out)
+		fmt.Fprint(opts.IO.Out, string(snippet))
+	}
+
+	if opts.credentialsFile == "" {
+		opts.Logger.Info("")
+		opts.Logger.Info(opts.localizer.MustLocalize("registry.describe.log.info.credentialsPlaceholders"))
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package registrycmdutil
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	registrymgmtclient "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
+)
+
+// Paths of the registry APIs, relative to the registry URL
+const (
+	CoreAPIPath    = "/apis/registry/v2"
+	CcompatAPIPath = "/apis/ccompat/v6"
+)
+
+// valid values for the client configuration snippet type
+const (
+	JavaSerdeSnippet = "java-serde"
+	QuarkusSnippet   = "quarkus"
+	EnvSnippet       = "env"
+)
+
+var ValidSnippetTypes = []string{JavaSerdeSnippet, QuarkusSnippet, EnvSnippet}
+
+// Endpoints contains the URLs used to connect to a Service Registry instance
+type Endpoints struct {
+	RegistryURL string
+	CoreAPIURL  string
+	CcompatURL  string
+	ConsoleURL  string
+}
+
+// GetEndpoints returns the endpoints of the registry.
+// The URLs are empty when the registry is not ready yet
+func GetEndpoints(registry *registrymgmtclient.Registry) *Endpoints {
+	endpoints := &Endpoints{
+		ConsoleURL: registry.GetBrowserUrl(),
+	}
+
+	registryURL := strings.TrimSuffix(registry.GetRegistryUrl(), "/")
+	if registryURL != "" {
+		endpoints.RegistryURL = registryURL
+		endpoints.CoreAPIURL = registryURL + CoreAPIPath
+		endpoints.CcompatURL = registryURL + CcompatAPIPath
+	}
+
+	return endpoints
+}
+
+// TokenURL returns the OAuth token URL of the authentication server.
+// The production server is used when the URL of the server is not configured
+func TokenURL(masAuthURL string) string {
+	if masAuthURL == "" {
+		masAuthURL = build.ProductionMasAuthURL
+	}
+	return strings.TrimSuffix(masAuthURL, "/") + "/protocol/openid-connect/token"
+}
+
+// SnippetConfig contains the values used to
+// generate a registry client configuration snippet
+type SnippetConfig struct {
+	Endpoints    *Endpoints
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+}
+
+// Templates
+var (
+	templateJavaSerde = heredoc.Doc(`
+	apicurio.registry.url={{.Endpoints.CoreAPIURL}}
+	apicurio.auth.service.token.endpoint={{.TokenURL}}
+	apicurio.auth.client.id={{.ClientID}}
+	apicurio.auth.client.secret={{.ClientSecret}}
+	`)
+
+	templateQuarkus = heredoc.Doc(`
+	mp.messaging.connector.smallrye-kafka.apicurio.registry.url={{.Endpoints.CoreAPIURL}}
+	mp.messaging.connector.smallrye-kafka.apicurio.auth.service.token.endpoint={{.TokenURL}}
+	mp.messaging.connector.smallrye-kafka.apicurio.auth.client.id={{.ClientID}}
+	mp.messaging.connector.smallrye-kafka.apicurio.auth.client.secret={{.ClientSecret}}
+	`)
+
+	templateEnv = heredoc.Doc(`
+	SERVICE_REGISTRY_URL={{.Endpoints.RegistryURL}}
+	SERVICE_REGISTRY_CORE_PATH={{.CorePath}}
+	SERVICE_REGISTRY_COMPAT_PATH={{.CcompatPath}}
+	RHOAS_SERVICE_ACCOUNT_CLIENT_ID={{.ClientID}}
+	RHOAS_SERVICE_ACCOUNT_CLIENT_SECRET={{.ClientSecret}}
+	RHOAS_SERVICE_ACCOUNT_OAUTH_TOKEN_URL={{.TokenURL}}
+	`)
+)
+
+var snippetTemplates = map[string]string{
+	JavaSerdeSnippet: templateJavaSerde,
+	QuarkusSnippet:   templateQuarkus,
+	EnvSnippet:       templateEnv,
+}
+
+// RenderSnippet generates the client configuration snippet for the given snippet type
+func RenderSnippet(snippetType string, cfg *SnippetConfig) ([]byte, error) {
+	tmplText, ok := snippetTemplates[snippetType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported snippet type %q", snippetType)
+	}
+
+	tmpl, err := template.New(snippetType).Parse(tmplText)
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		*SnippetConfig
+		CorePath    string
+		CcompatPath string
+	}{cfg, CoreAPIPath, CcompatAPIPath}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
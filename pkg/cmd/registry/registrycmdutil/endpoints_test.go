@@ -0,0 +1,88 @@
+package registrycmdutil
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/internal/testutil"
+
+	registrymgmtclient "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
+)
+
+func TestGetEndpoints(t *testing.T) {
+	registry := registrymgmtclient.Registry{}
+	registry.SetRegistryUrl("https://bu98.serviceregistry.rhcloud.com/t/5213600b-afc9-487e-8cc3-339f4248d706/")
+	registry.SetBrowserUrl("https://console.redhat.com/application-services/service-registry/t/5213600b-afc9-487e-8cc3-339f4248d706")
+
+	got := GetEndpoints(&registry)
+
+	want := Endpoints{
+		RegistryURL: "https://bu98.serviceregistry.rhcloud.com/t/5213600b-afc9-487e-8cc3-339f4248d706",
+		CoreAPIURL:  "https://bu98.serviceregistry.rhcloud.com/t/5213600b-afc9-487e-8cc3-339f4248d706/apis/registry/v2",
+		CcompatURL:  "https://bu98.serviceregistry.rhcloud.com/t/5213600b-afc9-487e-8cc3-339f4248d706/apis/ccompat/v6",
+		ConsoleURL:  "https://console.redhat.com/application-services/service-registry/t/5213600b-afc9-487e-8cc3-339f4248d706",
+	}
+	if *got != want {
+		t.Errorf("GetEndpoints() = %+v, want %+v", *got, want)
+	}
+
+	if empty := GetEndpoints(&registrymgmtclient.Registry{}); empty.CoreAPIURL != "" {
+		t.Errorf("GetEndpoints() of a registry without URL = %+v, want empty URLs", *empty)
+	}
+}
+
+func TestTokenURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		masAuthURL string
+		want       string
+	}{
+		{
+			name:       "Should use the configured authentication server",
+			masAuthURL: "https://sso.redhat.com/auth/realms/redhat-external/",
+			want:       "https://sso.redhat.com/auth/realms/redhat-external/protocol/openid-connect/token",
+		},
+		{
+			name: "Should use the production authentication server when none is configured",
+			want: build.ProductionMasAuthURL + "/protocol/openid-connect/token",
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TokenURL(tt.masAuthURL); got != tt.want {
+				t.Errorf("TokenURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderSnippet(t *testing.T) {
+	cfg := &SnippetConfig{
+		Endpoints: &Endpoints{
+			RegistryURL: "https://bu98.serviceregistry.rhcloud.com/t/5213600b-afc9-487e-8cc3-339f4248d706",
+			CoreAPIURL:  "https://bu98.serviceregistry.rhcloud.com/t/5213600b-afc9-487e-8cc3-339f4248d706/apis/registry/v2",
+			CcompatURL:  "https://bu98.serviceregistry.rhcloud.com/t/5213600b-afc9-487e-8cc3-339f4248d706/apis/ccompat/v6",
+		},
+		ClientID:     "srvc-acct-11111111-2222-3333-4444-555555555555",
+		ClientSecret: "66666666-7777-8888-9999-000000000000",
+		TokenURL:     "https://identity.api.openshift.com/auth/realms/rhoas/protocol/openid-connect/token",
+	}
+
+	for _, snippetType := range ValidSnippetTypes {
+		// nolint:scopelint
+		t.Run(snippetType, func(t *testing.T) {
+			got, err := RenderSnippet(snippetType, cfg)
+			if err != nil {
+				t.Fatalf("RenderSnippet() error = %v", err)
+			}
+
+			testutil.AssertGolden(t, snippetType, got)
+		})
+	}
+
+	if _, err := RenderSnippet("unknown", cfg); err == nil {
+		t.Error("RenderSnippet() of an unknown type should return an error")
+	}
+}
@@ -32,6 +32,19 @@ func GetInstanceAPIError(err error) (e registryinstanceclient.Error, ok bool) {
 	return e, ok
 }
 
+// GetRuleViolationError gets the causes of a rule violation from an error
+func GetRuleViolationError(err error) (e registryinstanceclient.RuleViolationError, ok bool) {
+	var apiError registryinstanceclient.GenericOpenAPIError
+
+	if ok = errors.As(err, &apiError); ok {
+		errModel := apiError.Model()
+
+		e, ok = errModel.(registryinstanceclient.RuleViolationError)
+	}
+
+	return e, ok
+}
+
 // TransformInstanceError code contains message that can be returned to the user
 func TransformInstanceError(err error) error {
 	mappedErr, ok := GetInstanceAPIError(err)
@@ -0,0 +1,140 @@
+package enable
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/rulecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	artifact   string
+	group      string
+	registryID string
+	ruleType   string
+	config     string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewEnableCommand creates a new command to enable a global or artifact rule
+func NewEnableCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "enable",
+		Short:   f.Localizer.MustLocalize("registry.rule.cmd.enable.shortDescription"),
+		Long:    f.Localizer.MustLocalize("registry.rule.cmd.enable.longDescription"),
+		Example: f.Localizer.MustLocalize("registry.rule.cmd.enable.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if opts.ruleType, err = rulecmdutil.NormalizeRuleType(opts.ruleType); err != nil {
+				return err
+			}
+
+			if opts.config, err = rulecmdutil.NormalizeConfig(opts.ruleType, opts.config); err != nil {
+				return err
+			}
+
+			if opts.artifact == "" && cmd.Flags().Changed("group") {
+				return opts.localizer.MustLocalizeError("registry.rule.common.error.groupWithoutArtifact")
+			}
+
+			if opts.registryID != "" {
+				return runEnable(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetServiceRegistryIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("artifact.cmd.common.error.noServiceRegistrySelected")
+			}
+
+			opts.registryID = instanceID
+			return runEnable(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ruleType, "rule-type", "", flagutil.FlagDescription(opts.localizer, "registry.rule.common.flag.ruleType", rulecmdutil.ValidRuleTypes...))
+	cmd.Flags().StringVar(&opts.config, "config", "", opts.localizer.MustLocalize("registry.rule.common.flag.config"))
+	cmd.Flags().StringVar(&opts.artifact, "artifact-id", "", opts.localizer.MustLocalize("registry.rule.common.flag.artifactId"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", util.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.instance.id"))
+
+	_ = cmd.MarkFlagRequired("rule-type")
+	_ = cmd.MarkFlagRequired("config")
+
+	flagutil.EnableStaticFlagCompletion(cmd, "rule-type", rulecmdutil.ValidRuleTypes)
+	flagutil.EnableStaticFlagCompletion(cmd, "config", rulecmdutil.AllValidConfigs())
+
+	return cmd
+}
+
+func runEnable(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	ruleClient := rulecmdutil.NewRuleClient(opts.Context, dataAPI, opts.group, opts.artifact)
+
+	ruleTypeEntry := localize.NewEntry("RuleType", opts.ruleType)
+
+	if err = ruleClient.Enable(opts.ruleType, opts.config); err != nil {
+		switch {
+		case rulecmdutil.IsConflictError(err):
+			return opts.localizer.MustLocalizeError("registry.rule.enable.error.alreadyEnabled", ruleTypeEntry)
+		case rulecmdutil.IsNotFoundError(err) && !ruleClient.IsGlobal():
+			return opts.localizer.MustLocalizeError("registry.rule.common.error.artifactNotFound",
+				localize.NewEntry("Group", opts.group),
+				localize.NewEntry("ArtifactID", opts.artifact),
+			)
+		default:
+			return registrycmdutil.TransformInstanceError(err)
+		}
+	}
+
+	configEntry := localize.NewEntry("Config", opts.config)
+	if ruleClient.IsGlobal() {
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("registry.rule.enable.log.info.globalRuleEnabled", ruleTypeEntry, configEntry))
+	} else {
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("registry.rule.enable.log.info.artifactRuleEnabled", ruleTypeEntry, configEntry,
+			localize.NewEntry("Group", opts.group),
+			localize.NewEntry("ArtifactID", opts.artifact),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package list
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/rulecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	artifact     string
+	group        string
+	registryID   string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// ruleRow contains the properties used to
+// populate the list of rules into a table row
+type ruleRow struct {
+	Type   string `json:"type" header:"Rule Type"`
+	Config string `json:"config" header:"Configuration"`
+}
+
+// NewListCommand creates a new command to list the global or artifact rules
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   f.Localizer.MustLocalize("registry.rule.cmd.list.shortDescription"),
+		Long:    f.Localizer.MustLocalize("registry.rule.cmd.list.longDescription"),
+		Example: f.Localizer.MustLocalize("registry.rule.cmd.list.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.artifact == "" && cmd.Flags().Changed("group") {
+				return opts.localizer.MustLocalizeError("registry.rule.common.error.groupWithoutArtifact")
+			}
+
+			if opts.registryID != "" {
+				return runList(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			instanceID, ok := cfg.GetServiceRegistryIdOk()
+			if !ok {
+				return opts.localizer.MustLocalizeError("artifact.cmd.common.error.noServiceRegistrySelected")
+			}
+
+			opts.registryID = instanceID
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.artifact, "artifact-id", "", opts.localizer.MustLocalize("registry.rule.common.flag.artifactId"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", util.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.instance.id"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("artifact.common.message.output.format"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	ruleClient := rulecmdutil.NewRuleClient(opts.Context, dataAPI, opts.group, opts.artifact)
+
+	rules, err := ruleClient.List()
+	if err != nil {
+		return err
+	}
+
+	if len(rules) == 0 && opts.outputFormat == "" {
+		if ruleClient.IsGlobal() {
+			opts.Logger.Info(opts.localizer.MustLocalize("registry.rule.list.log.info.noGlobalRules"))
+		} else {
+			opts.Logger.Info(opts.localizer.MustLocalize("registry.rule.list.log.info.noArtifactRules",
+				localize.NewEntry("Group", opts.group),
+				localize.NewEntry("ArtifactID", opts.artifact),
+			))
+		}
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case dump.EmptyFormat:
+		dump.Table(opts.IO.Out, mapRulesToRows(rules))
+		opts.Logger.Info("")
+	default:
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, rules)
+	}
+
+	return nil
+}
+
+func mapRulesToRows(rules []registryinstanceclient.Rule) []ruleRow {
+	rows := make([]ruleRow, len(rules))
+
+	for i, r := range rules {
+		rows[i] = ruleRow{
+			Type:   string(r.GetType()),
+			Config: r.GetConfig(),
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,30 @@
+package rule
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/disable"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/enable"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/update"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewRuleCommand creates a new command sub-group to manage the global and artifact rules of a Service Registry instance
+func NewRuleCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rule",
+		Short:   f.Localizer.MustLocalize("registry.rule.cmd.description.short"),
+		Long:    f.Localizer.MustLocalize("registry.rule.cmd.description.long"),
+		Example: f.Localizer.MustLocalize("registry.rule.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		list.NewListCommand(f),
+		enable.NewEnableCommand(f),
+		update.NewUpdateCommand(f),
+		disable.NewDisableCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,197 @@
+package rulecmdutil
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+// valid values for the rule type
+const (
+	ValidityRule      = string(registryinstanceclient.RULETYPE_VALIDITY)
+	CompatibilityRule = string(registryinstanceclient.RULETYPE_COMPATIBILITY)
+)
+
+var ValidRuleTypes = []string{ValidityRule, CompatibilityRule}
+
+// ValidityConfigs are the valid configurations of the VALIDITY rule
+var ValidityConfigs = []string{"FULL", "SYNTAX_ONLY", "NONE"}
+
+// CompatibilityConfigs are the valid configurations of the COMPATIBILITY rule
+var CompatibilityConfigs = []string{
+	"BACKWARD",
+	"BACKWARD_TRANSITIVE",
+	"FORWARD",
+	"FORWARD_TRANSITIVE",
+	"FULL",
+	"FULL_TRANSITIVE",
+	"NONE",
+}
+
+// GetValidConfigs returns the valid configurations of the rule type
+func GetValidConfigs(ruleType string) []string {
+	if ruleType == ValidityRule {
+		return ValidityConfigs
+	}
+	return CompatibilityConfigs
+}
+
+// AllValidConfigs returns the valid configurations of all rule types, without duplicates
+func AllValidConfigs() []string {
+	configs := append([]string{}, ValidityConfigs...)
+	for _, c := range CompatibilityConfigs {
+		if !flagutil.IsValidInput(c, configs...) {
+			configs = append(configs, c)
+		}
+	}
+	return configs
+}
+
+// NormalizeRuleType validates the rule type, ignoring its case
+func NormalizeRuleType(ruleType string) (string, error) {
+	normalized := strings.ToUpper(ruleType)
+	if !flagutil.IsValidInput(normalized, ValidRuleTypes...) {
+		return "", flagutil.InvalidValueError("rule-type", ruleType, ValidRuleTypes...)
+	}
+	return normalized, nil
+}
+
+// NormalizeConfig validates the configuration of the rule type, ignoring its case
+func NormalizeConfig(ruleType string, config string) (string, error) {
+	normalized := strings.ToUpper(config)
+	validConfigs := GetValidConfigs(ruleType)
+	if !flagutil.IsValidInput(normalized, validConfigs...) {
+		return "", flagutil.InvalidValueError("config", config, validConfigs...)
+	}
+	return normalized, nil
+}
+
+// RuleClient manages the rules of a registry instance, for the whole instance
+// or for a single artifact
+type RuleClient struct {
+	api        *registryinstanceclient.APIClient
+	ctx        context.Context
+	group      string
+	artifactID string
+}
+
+// NewRuleClient creates a client for the global rules, when artifactID is empty,
+// or for the rules of the artifact
+func NewRuleClient(ctx context.Context, api *registryinstanceclient.APIClient, group string, artifactID string) *RuleClient {
+	return &RuleClient{
+		api:        api,
+		ctx:        ctx,
+		group:      group,
+		artifactID: artifactID,
+	}
+}
+
+// IsGlobal checks if the client manages the global rules
+func (c *RuleClient) IsGlobal() bool {
+	return c.artifactID == ""
+}
+
+// List returns the enabled rules with their configuration
+func (c *RuleClient) List() ([]registryinstanceclient.Rule, error) {
+	var ruleTypes []registryinstanceclient.RuleType
+	var err error
+	if c.IsGlobal() {
+		ruleTypes, _, err = c.api.AdminApi.ListGlobalRules(c.ctx).Execute()
+	} else {
+		ruleTypes, _, err = c.api.ArtifactRulesApi.ListArtifactRules(c.ctx, c.group, c.artifactID).Execute()
+	}
+	if err != nil {
+		return nil, registrycmdutil.TransformInstanceError(err)
+	}
+
+	rules := make([]registryinstanceclient.Rule, 0, len(ruleTypes))
+	for _, ruleType := range ruleTypes {
+		rule, err := c.Get(string(ruleType))
+		if err != nil {
+			return nil, registrycmdutil.TransformInstanceError(err)
+		}
+		rules = append(rules, *rule)
+	}
+
+	return rules, nil
+}
+
+// Get returns the configuration of an enabled rule
+func (c *RuleClient) Get(ruleType string) (*registryinstanceclient.Rule, error) {
+	var rule registryinstanceclient.Rule
+	var err error
+	if c.IsGlobal() {
+		rule, _, err = c.api.AdminApi.GetGlobalRuleConfig(c.ctx, registryinstanceclient.RuleType(ruleType)).Execute()
+	} else {
+		rule, _, err = c.api.ArtifactRulesApi.GetArtifactRuleConfig(c.ctx, c.group, c.artifactID, ruleType).Execute()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the type is not always returned with the configuration
+	if rule.Type == nil {
+		rule.SetType(registryinstanceclient.RuleType(ruleType))
+	}
+
+	return &rule, nil
+}
+
+// Enable enables a rule which is not enabled yet
+func (c *RuleClient) Enable(ruleType string, config string) error {
+	rule := *registryinstanceclient.NewRule(config)
+	rule.SetType(registryinstanceclient.RuleType(ruleType))
+
+	var err error
+	if c.IsGlobal() {
+		_, err = c.api.AdminApi.CreateGlobalRule(c.ctx).Rule(rule).Execute()
+	} else {
+		_, err = c.api.ArtifactRulesApi.CreateArtifactRule(c.ctx, c.group, c.artifactID).Rule(rule).Execute()
+	}
+	return err
+}
+
+// Update changes the configuration of an enabled rule
+func (c *RuleClient) Update(ruleType string, config string) error {
+	rule := *registryinstanceclient.NewRule(config)
+	rule.SetType(registryinstanceclient.RuleType(ruleType))
+
+	var err error
+	if c.IsGlobal() {
+		_, _, err = c.api.AdminApi.UpdateGlobalRuleConfig(c.ctx, registryinstanceclient.RuleType(ruleType)).Rule2(rule).Execute()
+	} else {
+		_, _, err = c.api.ArtifactRulesApi.UpdateArtifactRuleConfig(c.ctx, c.group, c.artifactID, ruleType).Rule2(rule).Execute()
+	}
+	return err
+}
+
+// Disable disables an enabled rule
+func (c *RuleClient) Disable(ruleType string) error {
+	var err error
+	if c.IsGlobal() {
+		_, err = c.api.AdminApi.DeleteGlobalRule(c.ctx, registryinstanceclient.RuleType(ruleType)).Execute()
+	} else {
+		_, err = c.api.ArtifactRulesApi.DeleteArtifactRule(c.ctx, c.group, c.artifactID, ruleType).Execute()
+	}
+	return err
+}
+
+// IsErrorCode checks if the error of the registry instance API has the HTTP status code
+func IsErrorCode(err error, code int) bool {
+	apiError, ok := registrycmdutil.GetInstanceAPIError(err)
+	return ok && apiError.GetErrorCode() == int32(code)
+}
+
+// IsNotFoundError checks if the error of the registry instance API is caused by a missing rule or artifact
+func IsNotFoundError(err error) bool {
+	return IsErrorCode(err, http.StatusNotFound)
+}
+
+// IsConflictError checks if the error of the registry instance API is caused by a rule which is already enabled
+func IsConflictError(err error) bool {
+	return IsErrorCode(err, http.StatusConflict)
+}
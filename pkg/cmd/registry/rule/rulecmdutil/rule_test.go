@@ -0,0 +1,61 @@
+package rulecmdutil
+
+import "testing"
+
+func TestNormalizeConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		ruleType string
+		config   string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "Should accept a validity configuration in any case",
+			ruleType: ValidityRule,
+			config:   "syntax_only",
+			want:     "SYNTAX_ONLY",
+		},
+		{
+			name:     "Should accept a transitive compatibility configuration",
+			ruleType: CompatibilityRule,
+			config:   "BACKWARD_TRANSITIVE",
+			want:     "BACKWARD_TRANSITIVE",
+		},
+		{
+			name:     "Should reject a compatibility configuration for the validity rule",
+			ruleType: ValidityRule,
+			config:   "BACKWARD",
+			wantErr:  true,
+		},
+		{
+			name:     "Should reject a validity configuration for the compatibility rule",
+			ruleType: CompatibilityRule,
+			config:   "SYNTAX_ONLY",
+			wantErr:  true,
+		},
+	}
+
+	// nolint:scopelint
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeConfig(tt.ruleType, tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRuleType(t *testing.T) {
+	if got, err := NormalizeRuleType("compatibility"); err != nil || got != CompatibilityRule {
+		t.Errorf("NormalizeRuleType() = %v, %v, want %v", got, err, CompatibilityRule)
+	}
+
+	if _, err := NormalizeRuleType("INTEGRITY"); err == nil {
+		t.Error("NormalizeRuleType() expected error for unsupported rule type")
+	}
+}
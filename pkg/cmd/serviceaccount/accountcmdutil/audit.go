@@ -0,0 +1,170 @@
+package accountcmdutil
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// CSVFormat is the output format of the audit for spreadsheets
+const CSVFormat = "csv"
+
+// KafkaInstanceACLs contains the ACL bindings of a Kafka instance
+type KafkaInstanceACLs struct {
+	InstanceID   string
+	InstanceName string
+	Bindings     []aclcmdutil.Binding
+}
+
+// RegistryInstanceRoles contains the role mappings of a Service Registry instance, by principal
+type RegistryInstanceRoles struct {
+	InstanceID   string
+	InstanceName string
+	Roles        map[string]string
+}
+
+// KafkaBindingReference is an ACL binding of a Kafka instance which references a service account
+type KafkaBindingReference struct {
+	InstanceID   string `json:"instanceId" yaml:"instanceId"`
+	InstanceName string `json:"instanceName" yaml:"instanceName"`
+
+	aclcmdutil.Binding `yaml:",inline"`
+}
+
+// RegistryRoleReference is a role of a service account in a Service Registry instance
+type RegistryRoleReference struct {
+	InstanceID   string `json:"instanceId" yaml:"instanceId"`
+	InstanceName string `json:"instanceName" yaml:"instanceName"`
+	Role         string `json:"role" yaml:"role"`
+}
+
+// AccountAudit contains the usage of a service account across Kafka and Service Registry instances
+type AccountAudit struct {
+	ID               string                  `json:"id" yaml:"id"`
+	ClientID         string                  `json:"clientId" yaml:"clientId"`
+	Name             string                  `json:"name" yaml:"name"`
+	Owner            string                  `json:"owner" yaml:"owner"`
+	CreatedAt        time.Time               `json:"createdAt" yaml:"createdAt"`
+	KafkaBindings    []KafkaBindingReference `json:"kafkaBindings" yaml:"kafkaBindings"`
+	RegistryRoles    []RegistryRoleReference `json:"registryRoles" yaml:"registryRoles"`
+	SkippedInstances []string                `json:"skippedInstances" yaml:"skippedInstances"`
+	Orphaned         bool                    `json:"orphaned" yaml:"orphaned"`
+	ClusterAdmin     bool                    `json:"clusterAdmin" yaml:"clusterAdmin"`
+}
+
+// AuditAccounts finds the ACL bindings and Service Registry roles which reference each service account.
+// Bindings for all accounts are not references, since they do not show that the service account is used.
+// A service account is only orphaned when no instance was skipped, since the skipped instances might reference it
+func AuditAccounts(accounts []kafkamgmtclient.ServiceAccountListItem, kafkaACLs []KafkaInstanceACLs, registryRoles []RegistryInstanceRoles, skipped []string) []AccountAudit {
+	audits := make([]AccountAudit, len(accounts))
+
+	for i := range accounts {
+		account := &accounts[i]
+		clientID := account.GetClientId()
+		principal := aclcmdutil.FormatPrincipal(clientID)
+
+		audit := AccountAudit{
+			ID:               account.GetId(),
+			ClientID:         clientID,
+			Name:             account.GetName(),
+			Owner:            account.GetOwner(),
+			CreatedAt:        account.GetCreatedAt(),
+			KafkaBindings:    []KafkaBindingReference{},
+			RegistryRoles:    []RegistryRoleReference{},
+			SkippedInstances: append([]string{}, skipped...),
+		}
+
+		for _, instance := range kafkaACLs {
+			for _, b := range instance.Bindings {
+				if b.Principal != principal {
+					continue
+				}
+				audit.KafkaBindings = append(audit.KafkaBindings, KafkaBindingReference{
+					InstanceID:   instance.InstanceID,
+					InstanceName: instance.InstanceName,
+					Binding:      b,
+				})
+				if IsClusterAdminBinding(b) {
+					audit.ClusterAdmin = true
+				}
+			}
+		}
+
+		for _, instance := range registryRoles {
+			if role, ok := instance.Roles[clientID]; ok {
+				audit.RegistryRoles = append(audit.RegistryRoles, RegistryRoleReference{
+					InstanceID:   instance.InstanceID,
+					InstanceName: instance.InstanceName,
+					Role:         role,
+				})
+			}
+		}
+
+		audit.Orphaned = len(audit.KafkaBindings) == 0 && len(audit.RegistryRoles) == 0 && len(audit.SkippedInstances) == 0
+
+		audits[i] = audit
+	}
+
+	return audits
+}
+
+// Description describes the ACL binding
+func (r KafkaBindingReference) Description() string {
+	return fmt.Sprintf("%v %v %v:%v (%v)", r.Permission, r.Operation, r.ResourceType, r.ResourceName, r.PatternType)
+}
+
+// IsClusterAdminBinding checks if the binding allows an operation which changes the Kafka cluster
+func IsClusterAdminBinding(b aclcmdutil.Binding) bool {
+	if b.ResourceType != aclcmdutil.ResourceTypeCLUSTER || b.Permission != aclcmdutil.PermissionALLOW {
+		return false
+	}
+
+	return b.Operation != aclcmdutil.OperationDESCRIBE && b.Operation != aclcmdutil.OperationDESCRIBE_CONFIGS
+}
+
+// WriteAuditCSV writes one row for each service account. The references are joined in a single column
+func WriteAuditCSV(w io.Writer, audits []AccountAudit) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"id", "client_id", "name", "owner", "created_at", "orphaned", "cluster_admin", "kafka_bindings", "registry_roles", "skipped_instances"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, a := range audits {
+		kafkaBindings := make([]string, len(a.KafkaBindings))
+		for i, b := range a.KafkaBindings {
+			kafkaBindings[i] = fmt.Sprintf("%v: %v", b.InstanceName, b.Description())
+		}
+
+		registryRoles := make([]string, len(a.RegistryRoles))
+		for i, r := range a.RegistryRoles {
+			registryRoles[i] = fmt.Sprintf("%v: %v", r.InstanceName, r.Role)
+		}
+
+		record := []string{
+			a.ID,
+			a.ClientID,
+			a.Name,
+			a.Owner,
+			a.CreatedAt.Format(time.RFC3339),
+			strconv.FormatBool(a.Orphaned),
+			strconv.FormatBool(a.ClusterAdmin),
+			strings.Join(kafkaBindings, "; "),
+			strings.Join(registryRoles, "; "),
+			strings.Join(a.SkippedInstances, "; "),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
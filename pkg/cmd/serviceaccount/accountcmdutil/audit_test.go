@@ -0,0 +1,135 @@
+package accountcmdutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func TestAuditAccounts(t *testing.T) {
+	newAccount := func(id string, clientID string) kafkamgmtclient.ServiceAccountListItem {
+		account := kafkamgmtclient.ServiceAccountListItem{}
+		account.SetId(id)
+		account.SetClientId(clientID)
+		return account
+	}
+
+	newBinding := func(principal string, operation string, resourceType string) aclcmdutil.Binding {
+		return aclcmdutil.Binding{
+			Principal:    principal,
+			Permission:   aclcmdutil.PermissionALLOW,
+			Operation:    operation,
+			ResourceType: resourceType,
+			ResourceName: "*",
+			PatternType:  aclcmdutil.PatternTypeLITERAL,
+		}
+	}
+
+	accounts := []kafkamgmtclient.ServiceAccountListItem{
+		newAccount("producer", "srvc-acct-producer"),
+		newAccount("admin", "srvc-acct-admin"),
+		newAccount("registry", "srvc-acct-registry"),
+		newAccount("unused", "srvc-acct-unused"),
+	}
+
+	kafkaACLs := []KafkaInstanceACLs{
+		{
+			InstanceID:   "kafka-1",
+			InstanceName: "orders",
+			Bindings: []aclcmdutil.Binding{
+				newBinding("User:*", aclcmdutil.OperationDESCRIBE, aclcmdutil.ResourceTypeTOPIC),
+				newBinding("User:srvc-acct-producer", aclcmdutil.OperationWRITE, aclcmdutil.ResourceTypeTOPIC),
+				newBinding("User:srvc-acct-producer", aclcmdutil.OperationDESCRIBE, aclcmdutil.ResourceTypeCLUSTER),
+				newBinding("User:srvc-acct-admin", aclcmdutil.OperationALTER, aclcmdutil.ResourceTypeCLUSTER),
+			},
+		},
+	}
+
+	registryRoles := []RegistryInstanceRoles{
+		{InstanceID: "registry-1", InstanceName: "schemas", Roles: map[string]string{"srvc-acct-registry": "SR_READONLY"}},
+	}
+
+	audits := AuditAccounts(accounts, kafkaACLs, registryRoles, nil)
+
+	tests := []struct {
+		id                string
+		wantKafkaBindings int
+		wantRegistryRoles int
+		wantOrphaned      bool
+		wantClusterAdmin  bool
+	}{
+		{id: "producer", wantKafkaBindings: 2},
+		{id: "admin", wantKafkaBindings: 1, wantClusterAdmin: true},
+		{id: "registry", wantRegistryRoles: 1},
+		{id: "unused", wantOrphaned: true},
+	}
+
+	for i, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.id, func(t *testing.T) {
+			got := audits[i]
+			if got.ID != tt.id {
+				t.Fatalf("AuditAccounts() ID = %v, want %v", got.ID, tt.id)
+			}
+			if len(got.KafkaBindings) != tt.wantKafkaBindings {
+				t.Errorf("AuditAccounts() KafkaBindings = %v, want %v bindings", got.KafkaBindings, tt.wantKafkaBindings)
+			}
+			if len(got.RegistryRoles) != tt.wantRegistryRoles {
+				t.Errorf("AuditAccounts() RegistryRoles = %v, want %v roles", got.RegistryRoles, tt.wantRegistryRoles)
+			}
+			if got.Orphaned != tt.wantOrphaned {
+				t.Errorf("AuditAccounts() Orphaned = %v, want %v", got.Orphaned, tt.wantOrphaned)
+			}
+			if got.ClusterAdmin != tt.wantClusterAdmin {
+				t.Errorf("AuditAccounts() ClusterAdmin = %v, want %v", got.ClusterAdmin, tt.wantClusterAdmin)
+			}
+		})
+	}
+}
+
+func TestAuditAccountsWithSkippedInstances(t *testing.T) {
+	account := kafkamgmtclient.ServiceAccountListItem{}
+	account.SetId("unused")
+	account.SetClientId("srvc-acct-unused")
+
+	audits := AuditAccounts([]kafkamgmtclient.ServiceAccountListItem{account}, nil, nil, []string{"payments"})
+
+	got := audits[0]
+	if got.Orphaned {
+		t.Errorf("AuditAccounts() Orphaned = %v, want false when instances were skipped", got.Orphaned)
+	}
+	if len(got.SkippedInstances) != 1 || got.SkippedInstances[0] != "payments" {
+		t.Errorf("AuditAccounts() SkippedInstances = %v, want [payments]", got.SkippedInstances)
+	}
+}
+
+func TestWriteAuditCSV(t *testing.T) {
+	audits := []AccountAudit{
+		{
+			ID:        "registry",
+			ClientID:  "srvc-acct-registry",
+			Name:      "schemas, readers",
+			CreatedAt: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC),
+			RegistryRoles: []RegistryRoleReference{
+				{InstanceName: "schemas", Role: "SR_READONLY"},
+			},
+			SkippedInstances: []string{"orders", "payments"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteAuditCSV(&buf, audits); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `id,client_id,name,owner,created_at,orphaned,cluster_admin,kafka_bindings,registry_roles,skipped_instances
+registry,srvc-acct-registry,"schemas, readers",,2022-03-01T00:00:00Z,false,false,,schemas: SR_READONLY,orders; payments
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteAuditCSV() = %v, want %v", strings.TrimSpace(got), strings.TrimSpace(want))
+	}
+}
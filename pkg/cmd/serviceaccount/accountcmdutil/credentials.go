@@ -0,0 +1,53 @@
+package accountcmdutil
+
+import (
+	"sort"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// RecordCredentialsReset saves the time at which the credentials of the service account were reset.
+// The API does not expose this time, so it is kept in the configuration of the CLI
+func RecordCredentialsReset(cfg config.IConfig, id string, resetAt time.Time) error {
+	c, err := cfg.Load()
+	if err != nil {
+		return err
+	}
+
+	if c.CredentialsResets == nil {
+		c.CredentialsResets = map[string]time.Time{}
+	}
+	c.CredentialsResets[id] = resetAt
+
+	return cfg.Save(c)
+}
+
+// CredentialsAge returns the time since the credentials of the service account were last reset
+// with the CLI, or since the service account was created
+func CredentialsAge(account *kafkamgmtclient.ServiceAccountListItem, resets map[string]time.Time, now time.Time) time.Duration {
+	issuedAt := account.GetCreatedAt()
+	if resetAt, ok := resets[account.GetId()]; ok && resetAt.After(issuedAt) {
+		issuedAt = resetAt
+	}
+
+	return now.Sub(issuedAt)
+}
+
+// AccountsWithCredentialsOlderThan returns the service accounts whose credentials are older than the threshold,
+// from the oldest credentials to the newest
+func AccountsWithCredentialsOlderThan(accounts []kafkamgmtclient.ServiceAccountListItem, resets map[string]time.Time, threshold time.Duration, now time.Time) []kafkamgmtclient.ServiceAccountListItem {
+	var expired []kafkamgmtclient.ServiceAccountListItem
+	for i := range accounts {
+		if CredentialsAge(&accounts[i], resets, now) > threshold {
+			expired = append(expired, accounts[i])
+		}
+	}
+
+	sort.SliceStable(expired, func(i, j int) bool {
+		return CredentialsAge(&expired[i], resets, now) > CredentialsAge(&expired[j], resets, now)
+	})
+
+	return expired
+}
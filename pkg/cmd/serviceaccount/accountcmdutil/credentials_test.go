@@ -0,0 +1,70 @@
+package accountcmdutil
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func TestAccountsWithCredentialsOlderThan(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	newAccount := func(id string, age time.Duration) kafkamgmtclient.ServiceAccountListItem {
+		account := kafkamgmtclient.ServiceAccountListItem{}
+		account.SetId(id)
+		account.SetCreatedAt(now.Add(-age))
+		return account
+	}
+
+	accounts := []kafkamgmtclient.ServiceAccountListItem{
+		newAccount("new", 10*day),
+		newAccount("old", 100*day),
+		newAccount("reset", 200*day),
+		newAccount("oldest", 300*day),
+	}
+
+	tests := []struct {
+		name      string
+		resets    map[string]time.Time
+		threshold time.Duration
+		wantIDs   []string
+	}{
+		{
+			name:      "Should return the accounts created before the threshold, oldest first",
+			threshold: 90 * day,
+			wantIDs:   []string{"oldest", "reset", "old"},
+		},
+		{
+			name:      "Should use the time of the last reset of the credentials",
+			resets:    map[string]time.Time{"reset": now.Add(-5 * day)},
+			threshold: 90 * day,
+			wantIDs:   []string{"oldest", "old"},
+		},
+		{
+			name:      "Should ignore a reset before the creation of the account",
+			resets:    map[string]time.Time{"oldest": now.Add(-400 * day)},
+			threshold: 250 * day,
+			wantIDs:   []string{"oldest"},
+		},
+		{
+			name:      "Should return no accounts when all credentials are newer than the threshold",
+			threshold: 365 * day,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIDs []string
+			for _, account := range AccountsWithCredentialsOlderThan(accounts, tt.resets, tt.threshold, now) {
+				gotIDs = append(gotIDs, account.GetId())
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("AccountsWithCredentialsOlderThan() = %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
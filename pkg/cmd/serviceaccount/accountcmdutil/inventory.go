@@ -0,0 +1,92 @@
+package accountcmdutil
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// InventoryRecord contains the metadata of a service account which is exported to an inventory
+type InventoryRecord struct {
+	ID                 string            `json:"id" yaml:"id"`
+	ClientID           string            `json:"clientId" yaml:"clientId"`
+	Name               string            `json:"name" yaml:"name"`
+	Owner              string            `json:"owner" yaml:"owner"`
+	CreatedAt          time.Time         `json:"createdAt" yaml:"createdAt"`
+	Labels             map[string]string `json:"labels" yaml:"labels"`
+	ExpiresAt          string            `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+	Expired            bool              `json:"expired" yaml:"expired"`
+	CredentialsResetAt *time.Time        `json:"credentialsResetAt,omitempty" yaml:"credentialsResetAt,omitempty"`
+}
+
+// BuildInventory returns the inventory records of the service accounts.
+// The credentials reset times are the ones recorded by the CLI
+func BuildInventory(accounts []kafkamgmtclient.ServiceAccountListItem, resets map[string]time.Time, now time.Time) []InventoryRecord {
+	records := make([]InventoryRecord, len(accounts))
+
+	for i := range accounts {
+		account := &accounts[i]
+		labels := ParseLabels(account.GetDescription())
+
+		record := InventoryRecord{
+			ID:        account.GetId(),
+			ClientID:  account.GetClientId(),
+			Name:      account.GetName(),
+			Owner:     account.GetOwner(),
+			CreatedAt: account.GetCreatedAt(),
+			Labels:    labels,
+			Expired:   IsExpired(labels, now),
+		}
+
+		if expiresAt, ok := ExpiresAt(labels); ok {
+			record.ExpiresAt = expiresAt.Format(ExpiryDateLayout)
+		}
+
+		if resetAt, ok := resets[record.ID]; ok {
+			resetAt := resetAt
+			record.CredentialsResetAt = &resetAt
+		}
+
+		records[i] = record
+	}
+
+	return records
+}
+
+// WriteInventoryCSV writes one row for each service account. The labels are joined in a single column
+func WriteInventoryCSV(w io.Writer, records []InventoryRecord) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"id", "client_id", "name", "owner", "created_at", "labels", "expires_at", "expired", "credentials_reset_at"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		var resetAt string
+		if r.CredentialsResetAt != nil {
+			resetAt = r.CredentialsResetAt.Format(time.RFC3339)
+		}
+
+		record := []string{
+			r.ID,
+			r.ClientID,
+			r.Name,
+			r.Owner,
+			r.CreatedAt.Format(time.RFC3339),
+			FormatLabels(r.Labels),
+			r.ExpiresAt,
+			strconv.FormatBool(r.Expired),
+			resetAt,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,129 @@
+package accountcmdutil
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// ExpiresAtLabel is the label which contains the date on which a service account expires
+const ExpiresAtLabel = "expires-at"
+
+// ExpiryDateLayout is the layout of the value of the expiry label
+const ExpiryDateLayout = "2006-01-02"
+
+const (
+	labelSeparator         = ","
+	labelKeyValueSeparator = "="
+)
+
+// ParseLabels returns the labels stored in the description of a service account.
+// The description contains the labels as comma-separated "key=value" pairs. Other text is ignored
+func ParseLabels(description string) map[string]string {
+	labels := map[string]string{}
+
+	for _, pair := range strings.Split(description, labelSeparator) {
+		key, value, ok := SplitLabel(strings.TrimSpace(pair))
+		if ok {
+			labels[key] = value
+		}
+	}
+
+	return labels
+}
+
+// FormatLabels returns the description of a service account which stores the labels, sorted by key
+func FormatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + labelKeyValueSeparator + labels[k]
+	}
+
+	return strings.Join(pairs, labelSeparator)
+}
+
+// SplitLabel splits a "key=value" label into the key and value
+func SplitLabel(label string) (key string, value string, ok bool) {
+	i := strings.Index(label, labelKeyValueSeparator)
+	if i <= 0 {
+		return "", "", false
+	}
+	return label[:i], label[i+1:], true
+}
+
+// LabelsFromFlags returns the labels from "key=value" values of the "--label" flag.
+// The values must have been validated
+func LabelsFromFlags(values []string) map[string]string {
+	labels := make(map[string]string, len(values))
+	for _, v := range values {
+		if key, value, ok := SplitLabel(v); ok {
+			labels[key] = value
+		}
+	}
+	return labels
+}
+
+// ValidateLabelFlags validates the values of the "--label" and "--expires-at" flags
+func ValidateLabelFlags(validator *validation.Validator, labels []string, expiresAt string) error {
+	for _, label := range labels {
+		if err := validator.ValidateLabel(label); err != nil {
+			return err
+		}
+	}
+
+	if expiresAt != "" {
+		return validator.ValidateExpiryDate(expiresAt)
+	}
+
+	return nil
+}
+
+// MatchLabels checks if the labels contain all the keys and values of the selector
+func MatchLabels(labels map[string]string, selector map[string]string) bool {
+	for k, v := range selector {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterAccountsByLabels returns the service accounts which have all the labels of the selector
+func FilterAccountsByLabels(accounts []kafkamgmtclient.ServiceAccountListItem, selector map[string]string) []kafkamgmtclient.ServiceAccountListItem {
+	filtered := []kafkamgmtclient.ServiceAccountListItem{}
+	for _, account := range accounts {
+		if MatchLabels(ParseLabels(account.GetDescription()), selector) {
+			filtered = append(filtered, account)
+		}
+	}
+	return filtered
+}
+
+// ExpiresAt returns the date on which a service account with the labels expires, if any
+func ExpiresAt(labels map[string]string) (time.Time, bool) {
+	value, ok := labels[ExpiresAtLabel]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	expiresAt, err := time.Parse(ExpiryDateLayout, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expiresAt, true
+}
+
+// IsExpired checks if a service account with the labels has expired
+func IsExpired(labels map[string]string, now time.Time) bool {
+	expiresAt, ok := ExpiresAt(labels)
+	return ok && !now.Before(expiresAt)
+}
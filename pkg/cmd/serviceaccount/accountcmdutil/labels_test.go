@@ -0,0 +1,136 @@
+package accountcmdutil
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        map[string]string
+	}{
+		{
+			name:        "Should return no labels for an empty description",
+			description: "",
+			want:        map[string]string{},
+		},
+		{
+			name:        "Should parse comma-separated labels",
+			description: "env=prod,team=payments",
+			want:        map[string]string{"env": "prod", "team": "payments"},
+		},
+		{
+			name:        "Should ignore text which is not a label",
+			description: "payments app, team=payments",
+			want:        map[string]string{"team": "payments"},
+		},
+		{
+			name:        "Should keep empty values",
+			description: "owner=",
+			want:        map[string]string{"owner": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseLabels(tt.description); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatLabels(t *testing.T) {
+	labels := map[string]string{"team": "payments", "env": "prod", ExpiresAtLabel: "2022-12-31"}
+
+	want := "env=prod,expires-at=2022-12-31,team=payments"
+	got := FormatLabels(labels)
+	if got != want {
+		t.Errorf("FormatLabels() = %v, want %v", got, want)
+	}
+
+	if parsed := ParseLabels(got); !reflect.DeepEqual(parsed, labels) {
+		t.Errorf("ParseLabels(FormatLabels()) = %v, want %v", parsed, labels)
+	}
+}
+
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{"team": "payments", "env": "prod"}
+
+	tests := []struct {
+		name     string
+		selector map[string]string
+		want     bool
+	}{
+		{
+			name:     "Should match an empty selector",
+			selector: map[string]string{},
+			want:     true,
+		},
+		{
+			name:     "Should match when all labels are present",
+			selector: map[string]string{"team": "payments", "env": "prod"},
+			want:     true,
+		},
+		{
+			name:     "Should not match a different value",
+			selector: map[string]string{"team": "billing"},
+			want:     false,
+		},
+		{
+			name:     "Should not match a missing label",
+			selector: map[string]string{"team": "payments", "region": "eu"},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchLabels(labels, tt.selector); got != tt.want {
+				t.Errorf("MatchLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{
+			name:   "Should not expire without the label",
+			labels: map[string]string{},
+			want:   false,
+		},
+		{
+			name:   "Should expire on the date of the label",
+			labels: map[string]string{ExpiresAtLabel: "2022-03-01"},
+			want:   true,
+		},
+		{
+			name:   "Should not expire before the date of the label",
+			labels: map[string]string{ExpiresAtLabel: "2022-03-02"},
+			want:   false,
+		},
+		{
+			name:   "Should ignore an invalid date",
+			labels: map[string]string{ExpiresAtLabel: "soon"},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExpired(tt.labels, now); got != tt.want {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
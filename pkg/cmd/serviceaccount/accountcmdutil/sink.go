@@ -0,0 +1,174 @@
+package accountcmdutil
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cluster"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/constants"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/kubeclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/v1alpha"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccountutil/credentials"
+	"github.com/spf13/cobra"
+
+	// Get all auth schemes
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+)
+
+// Destinations of service account credentials
+const (
+	SinkFile       = "file"
+	SinkStdout     = "stdout"
+	SinkKubernetes = "kubernetes"
+	SinkNetrc      = "netrc"
+)
+
+// CredentialsSinks are the valid values of the "--output-sink" flag
+var CredentialsSinks = []string{SinkFile, SinkStdout, SinkKubernetes, SinkNetrc}
+
+// CredentialsSink describes where the credentials of a service account are saved
+type CredentialsSink struct {
+	Sink       string
+	FileFormat string
+	// FilePath is the path of the credentials file, or of the ".netrc" file
+	FilePath string
+
+	Namespace  string
+	SecretName string
+	Kubeconfig string
+	// Overwrite allows replacing the credentials of another service account in the secret
+	Overwrite bool
+
+	clusterAPI *cluster.KubernetesClusterAPIImpl
+}
+
+// AddCredentialsSinkFlags adds the flags which select where the credentials are saved, other than the file flags
+func AddCredentialsSinkFlags(cmd *cobra.Command, localizer localize.Localizer, sink *CredentialsSink) {
+	cmd.Flags().StringVar(&sink.Sink, "output-sink", SinkFile, flagutil.FlagDescription(localizer, "serviceAccount.common.flag.outputSink.description", CredentialsSinks...))
+	cmd.Flags().StringVar(&sink.SecretName, "secret-name", constants.ServiceAccountSecretName, localizer.MustLocalize("serviceAccount.common.flag.secretName.description"))
+	cmd.Flags().StringVarP(&sink.Namespace, "namespace", "n", "", localizer.MustLocalize("cluster.common.flag.namespace.description"))
+	cmd.Flags().StringVar(&sink.Kubeconfig, "kubeconfig", "", localizer.MustLocalize("cluster.common.flag.kubeconfig.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output-sink", CredentialsSinks)
+}
+
+// Validate checks that the sink is valid
+func (s *CredentialsSink) Validate() error {
+	if !flagutil.IsValidInput(s.Sink, CredentialsSinks...) {
+		return flagutil.InvalidValueError("output-sink", s.Sink, CredentialsSinks...)
+	}
+	return nil
+}
+
+// ValidateFileFlags checks that the file flags of the command are only used with sinks which use them
+func (s *CredentialsSink) ValidateFileFlags(cmd *cobra.Command, localizer localize.Localizer) error {
+	if cmd.Flags().Changed("file-format") && !s.NeedsFileFormat() {
+		return localizer.MustLocalizeError("serviceAccount.common.error.flagNotUsedBySink", localize.NewEntry("Flag", "file-format"), localize.NewEntry("Sink", s.Sink))
+	}
+	if cmd.Flags().Changed("output-file") && s.Sink != SinkFile && s.Sink != SinkNetrc {
+		return localizer.MustLocalizeError("serviceAccount.common.error.flagNotUsedBySink", localize.NewEntry("Flag", "output-file"), localize.NewEntry("Sink", s.Sink))
+	}
+	return nil
+}
+
+// Prepare checks that the credentials of the service account with the client ID can be saved to the sink.
+// It must be called before the credentials are issued, since the client secret cannot be retrieved again
+func (s *CredentialsSink) Prepare(env *v1alpha.CommandEnvironment, clientID string) error {
+	if s.Sink != SinkKubernetes {
+		return nil
+	}
+
+	kubeClients, err := kubeclient.NewKubernetesClusterClients(env, s.Kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	s.clusterAPI = &cluster.KubernetesClusterAPIImpl{
+		KubernetesClients:  kubeClients,
+		CommandEnvironment: env,
+	}
+
+	_, err = s.clusterAPI.ExecuteCheckCredentialsSecret(s.secretOptions(clientID, nil))
+	return err
+}
+
+func (s *CredentialsSink) secretOptions(clientID string, data map[string]string) *v1alpha.SaveCredentialsSecretOperationOptions {
+	return &v1alpha.SaveCredentialsSecretOperationOptions{
+		Namespace:  s.Namespace,
+		SecretName: s.SecretName,
+		ClientID:   clientID,
+		Overwrite:  s.Overwrite,
+		Data:       data,
+	}
+}
+
+// IsFile checks if the credentials are saved to a file in the selected format, which can already exist
+func (s *CredentialsSink) IsFile() bool {
+	return s.Sink == SinkFile
+}
+
+// NeedsFileFormat checks if the credentials are written in the selected format
+func (s *CredentialsSink) NeedsFileFormat() bool {
+	return s.Sink == SinkFile || s.Sink == SinkStdout
+}
+
+// Save saves the credentials to the sink. The Kubernetes sink must be prepared first
+func (s *CredentialsSink) Save(env *v1alpha.CommandEnvironment, creds *credentials.Credentials) error {
+	localizer := env.Localizer
+
+	switch s.Sink {
+	case SinkStdout:
+		if env.IO.IsStdoutTTY() {
+			env.Logger.Info(icon.InfoPrefix(), localizer.MustLocalize("serviceAccount.common.log.info.credentialsPrintedToTerminal"))
+		}
+
+		data, err := credentials.Render(s.FileFormat, creds)
+		if err != nil {
+			return err
+		}
+		_, err = env.IO.Out.Write(data)
+		return err
+	case SinkKubernetes:
+		result, err := s.clusterAPI.ExecuteSaveCredentialsSecret(s.secretOptions(creds.ClientID, credentials.SecretData(creds)))
+		if err != nil {
+			return err
+		}
+
+		env.Logger.Info(icon.SuccessPrefix(), localizer.MustLocalize("serviceAccount.common.log.info.credentialsSavedToSecret",
+			localize.NewEntry("Name", color.Info(s.SecretName)),
+			localize.NewEntry("Namespace", color.Info(result.Namespace)),
+			localize.NewEntry("ClientID", color.Success(creds.ClientID)),
+		))
+		return nil
+	case SinkNetrc:
+		filePath := s.FilePath
+		if filePath == "" {
+			defaultPath, err := credentials.DefaultNetrcPath()
+			if err != nil {
+				return err
+			}
+			filePath = defaultPath
+		}
+
+		if err := credentials.WriteNetrc(filePath, creds); err != nil {
+			return err
+		}
+
+		env.Logger.Info(icon.SuccessPrefix(), localizer.MustLocalize("serviceAccount.common.log.info.credentialsSaved",
+			localize.NewEntry("FilePath", color.CodeSnippet(filePath)),
+			localize.NewEntry("ClientID", color.Success(creds.ClientID)),
+		))
+		return nil
+	default:
+		if err := credentials.Write(s.FileFormat, s.FilePath, creds); err != nil {
+			return err
+		}
+
+		env.Logger.Info(icon.SuccessPrefix(), localizer.MustLocalize("serviceAccount.common.log.info.credentialsSaved",
+			localize.NewEntry("FilePath", color.CodeSnippet(s.FilePath)),
+			localize.NewEntry("ClientID", color.Success(creds.ClientID)),
+		))
+		return nil
+	}
+}
@@ -0,0 +1,45 @@
+package accountcmdutil
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+	"github.com/spf13/cobra"
+)
+
+func TestValidateFileFlags(t *testing.T) {
+	localizer, _ := goi18n.New(nil)
+
+	tests := []struct {
+		name    string
+		sink    string
+		args    []string
+		wantErr bool
+	}{
+		{name: "Should accept the file flags with the file sink", sink: SinkFile, args: []string{"--file-format", "env", "--output-file", "creds.env"}},
+		{name: "Should accept the file format with the stdout sink", sink: SinkStdout, args: []string{"--file-format", "json"}},
+		{name: "Should accept the output file with the netrc sink", sink: SinkNetrc, args: []string{"--output-file", "netrc"}},
+		{name: "Should accept the kubernetes sink without file flags", sink: SinkKubernetes},
+		{name: "Should reject the file format with the kubernetes sink", sink: SinkKubernetes, args: []string{"--file-format", "env"}, wantErr: true},
+		{name: "Should reject the file format with the netrc sink", sink: SinkNetrc, args: []string{"--file-format", "env"}, wantErr: true},
+		{name: "Should reject the output file with the stdout sink", sink: SinkStdout, args: []string{"--output-file", "creds.env"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		// nolint:scopelint
+		t.Run(tt.name, func(t *testing.T) {
+			var fileFormat, outputFile string
+			cmd := &cobra.Command{}
+			cmd.Flags().StringVar(&fileFormat, "file-format", "", "")
+			cmd.Flags().StringVar(&outputFile, "output-file", "", "")
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			sink := &CredentialsSink{Sink: tt.sink}
+			if err := sink.ValidateFileFlags(cmd, localizer); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFileFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
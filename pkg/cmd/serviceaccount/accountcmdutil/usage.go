@@ -0,0 +1,89 @@
+package accountcmdutil
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection/api"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistryutil"
+)
+
+// InstancesUsage contains the ACL bindings and role mappings of the instances which the user can access
+type InstancesUsage struct {
+	KafkaACLs     []KafkaInstanceACLs
+	RegistryRoles []RegistryInstanceRoles
+	// Skipped contains the names of the instances which could not be inspected
+	Skipped []string
+}
+
+// CollectInstancesUsage fetches the ACL bindings of all Kafka instances and the role mappings of all Service Registry instances.
+// Instances which cannot be inspected, for example because the user is not allowed to view their ACL bindings, are skipped
+func CollectInstancesUsage(ctx context.Context, conn api.API, localizer localize.Localizer, logger logging.Logger) (*InstancesUsage, error) {
+	usage := &InstancesUsage{}
+
+	kafkas, _, err := kafkautil.ListAllKafkas(ctx, conn.KafkaMgmt())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range kafkas {
+		instanceID := kafkas[i].GetId()
+		instanceName := kafkas[i].GetName()
+
+		adminAPI, _, err := conn.KafkaAdmin(instanceID)
+		if err != nil {
+			logger.Debug(err)
+			usage.Skipped = append(usage.Skipped, instanceName)
+			continue
+		}
+
+		bindings, httpRes, err := aclcmdutil.ListAllACLs(ctx, adminAPI)
+		if err = aclcmdutil.ValidateAPIError(httpRes, localizer, err, "list", instanceName); err != nil {
+			logger.Debug(err)
+			usage.Skipped = append(usage.Skipped, instanceName)
+			continue
+		}
+
+		instanceACLs := KafkaInstanceACLs{InstanceID: instanceID, InstanceName: instanceName}
+		for _, b := range bindings {
+			instanceACLs.Bindings = append(instanceACLs.Bindings, aclcmdutil.NewBinding(b))
+		}
+		usage.KafkaACLs = append(usage.KafkaACLs, instanceACLs)
+	}
+
+	registries, _, err := serviceregistryutil.ListAllServiceRegistries(ctx, conn.ServiceRegistryMgmt())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range registries {
+		instanceID := registries[i].GetId()
+		instanceName := registries[i].GetName()
+
+		dataAPI, _, err := conn.ServiceRegistryInstance(instanceID)
+		if err != nil {
+			logger.Debug(err)
+			usage.Skipped = append(usage.Skipped, instanceName)
+			continue
+		}
+
+		mappings, _, err := dataAPI.AdminApi.ListRoleMappings(ctx).Execute()
+		if err != nil {
+			logger.Debug(registrycmdutil.TransformInstanceError(err))
+			usage.Skipped = append(usage.Skipped, instanceName)
+			continue
+		}
+
+		instanceRoles := RegistryInstanceRoles{InstanceID: instanceID, InstanceName: instanceName, Roles: map[string]string{}}
+		for _, m := range mappings {
+			instanceRoles.Roles[m.GetPrincipalId()] = string(m.GetRole())
+		}
+		usage.RegistryRoles = append(usage.RegistryRoles, instanceRoles)
+	}
+
+	return usage, nil
+}
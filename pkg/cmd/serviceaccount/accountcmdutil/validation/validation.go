@@ -1,9 +1,11 @@
 package validation
 
 import (
+	"regexp"
+	"time"
+
 	"github.com/redhat-developer/app-services-cli/pkg/core/errors"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
-	"regexp"
 )
 
 const (
@@ -12,6 +14,11 @@ const (
 	maxNameLength              = 50
 	minNameLength              = 1
 	legalUUID                  = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
+
+	// label validation rules
+	legalLabel           = "^[a-zA-Z0-9]([-_.a-zA-Z0-9]*[a-zA-Z0-9])?=[-_.:/a-zA-Z0-9]*$"
+	maxDescriptionLength = 255
+	expiryDateLayout     = "2006-01-02"
 )
 
 // Validator is a type for validating service account configuration values
@@ -56,3 +63,48 @@ func (v *Validator) ValidateUUID(val interface{}) error {
 
 	return v.Localizer.MustLocalizeError("serviceAccount.common.validation.id.error.invalidID", localize.NewEntry("ID", id))
 }
+
+// ValidateLabel validates a label in "key=value" format.
+// Commas are not accepted, since they separate the labels in the description of the service account
+func (v *Validator) ValidateLabel(val interface{}) error {
+	label, ok := val.(string)
+	if !ok {
+		return errors.NewCastError(val, "string")
+	}
+
+	matched, _ := regexp.Match(legalLabel, []byte(label))
+
+	if matched {
+		return nil
+	}
+
+	return v.Localizer.MustLocalizeError("serviceAccount.common.validation.label.error.invalidLabel", localize.NewEntry("Label", label))
+}
+
+// ValidateLabelsLength validates that the description which stores the labels is not too long
+func (v *Validator) ValidateLabelsLength(val interface{}) error {
+	description, ok := val.(string)
+	if !ok {
+		return errors.NewCastError(val, "string")
+	}
+
+	if len(description) > maxDescriptionLength {
+		return v.Localizer.MustLocalizeError("serviceAccount.common.validation.label.error.lengthError", localize.NewEntry("MaxLen", maxDescriptionLength))
+	}
+
+	return nil
+}
+
+// ValidateExpiryDate validates that the expiry date is in YYYY-MM-DD format
+func (v *Validator) ValidateExpiryDate(val interface{}) error {
+	date, ok := val.(string)
+	if !ok {
+		return errors.NewCastError(val, "string")
+	}
+
+	if _, err := time.Parse(expiryDateLayout, date); err != nil {
+		return v.Localizer.MustLocalizeError("serviceAccount.common.validation.expiresAt.error.invalidDate", localize.NewEntry("Date", date))
+	}
+
+	return nil
+}
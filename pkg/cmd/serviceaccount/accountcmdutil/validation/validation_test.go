@@ -131,3 +131,54 @@ func TestValidateUUID(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLabel(t *testing.T) {
+	type args struct {
+		val interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "passes for key and value",
+			args:    args{"team=payments"},
+			wantErr: false,
+		},
+		{
+			name:    "passes for empty value",
+			args:    args{"owner="},
+			wantErr: false,
+		},
+		{
+			name:    "passes for value with URL characters",
+			args:    args{"repo=github.com/org/app"},
+			wantErr: false,
+		},
+		{
+			name:    "fails without separator",
+			args:    args{"team"},
+			wantErr: true,
+		},
+		{
+			name:    "fails for empty key",
+			args:    args{"=payments"},
+			wantErr: true,
+		},
+		{
+			name:    "fails for value with comma",
+			args:    args{"team=payments,billing"},
+			wantErr: true,
+		},
+	}
+
+	// nolint:scopelint
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validator.ValidateLabel(tt.args.val); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLabel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
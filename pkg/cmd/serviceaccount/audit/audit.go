@@ -0,0 +1,190 @@
+package audit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+var validOutputFormats = append(append([]string{}, flagutil.ValidOutputFormats...), accountcmdutil.CSVFormat)
+
+type options struct {
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	IO         *iostreams.IOStreams
+	localizer  localize.Localizer
+	Context    context.Context
+
+	id     string
+	output string
+}
+
+// auditRow contains the properties used to
+// populate the audit of service accounts into a table row
+type auditRow struct {
+	ID            string `json:"id" header:"ID"`
+	ClientID      string `json:"clientID" header:"Client ID"`
+	Name          string `json:"name" header:"Short Description"`
+	KafkaBindings int    `json:"kafkaBindings" header:"Kafka ACLs"`
+	RegistryRoles int    `json:"registryRoles" header:"Registry Roles"`
+	Findings      string `json:"findings" header:"Findings"`
+}
+
+// NewAuditCommand creates a new command to audit the usage of service accounts
+func NewAuditCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "audit",
+		Short:   opts.localizer.MustLocalize("serviceAccount.audit.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("serviceAccount.audit.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("serviceAccount.audit.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.output != "" && !flagutil.IsValidInput(opts.output, validOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.output, validOutputFormats...)
+			}
+
+			if opts.id != "" {
+				validator := &validation.Validator{
+					Localizer: opts.localizer,
+				}
+
+				if err := validator.ValidateUUID(opts.id); err != nil {
+					return err
+				}
+			}
+
+			return runAudit(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", flagutil.FlagDescription(opts.localizer, "flag.common.output.description", validOutputFormats...))
+	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.audit.flag.id.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output", validOutputFormats)
+
+	return cmd
+}
+
+// nolint:funlen
+func runAudit(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	var accounts []kafkamgmtclient.ServiceAccountListItem
+	if opts.id != "" {
+		serviceAccount, httpRes, err := api.ServiceAccountMgmt().GetServiceAccountById(opts.Context, opts.id).Execute()
+		if httpRes != nil {
+			defer httpRes.Body.Close()
+		}
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, kafkamgmtclient.ServiceAccountListItem{
+			Id:        serviceAccount.Id,
+			ClientId:  serviceAccount.ClientId,
+			Name:      serviceAccount.Name,
+			Owner:     serviceAccount.Owner,
+			CreatedAt: serviceAccount.CreatedAt,
+		})
+	} else {
+		res, httpRes, err := api.ServiceAccountMgmt().GetServiceAccounts(opts.Context).Execute()
+		if httpRes != nil {
+			defer httpRes.Body.Close()
+		}
+		if err != nil {
+			return err
+		}
+		accounts = res.GetItems()
+	}
+
+	if len(accounts) == 0 && opts.output == "" {
+		opts.Logger.Info(opts.localizer.MustLocalize("serviceAccount.list.log.info.noneFound"))
+		return nil
+	}
+
+	spinnr := spinner.New(opts.IO.ErrOut, opts.localizer)
+	spinnr.SetLocalizedSuffix("serviceAccount.audit.log.info.auditing")
+	spinnr.Start()
+
+	usage, err := accountcmdutil.CollectInstancesUsage(opts.Context, api, opts.localizer, opts.Logger)
+	spinnr.Stop()
+	if err != nil {
+		return err
+	}
+
+	if len(usage.Skipped) > 0 {
+		opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalizePlural("serviceAccount.audit.log.info.instancesSkipped", len(usage.Skipped),
+			localize.NewEntry("Instances", strings.Join(usage.Skipped, ", ")),
+		))
+	}
+
+	audits := accountcmdutil.AuditAccounts(accounts, usage.KafkaACLs, usage.RegistryRoles, usage.Skipped)
+
+	switch opts.output {
+	case dump.EmptyFormat:
+		dump.Table(opts.IO.Out, mapAuditsToRows(audits, opts.localizer))
+		opts.Logger.Info("")
+	case accountcmdutil.CSVFormat:
+		return accountcmdutil.WriteAuditCSV(opts.IO.Out, audits)
+	default:
+		return dump.Formatted(opts.IO.Out, opts.output, audits)
+	}
+
+	return nil
+}
+
+func mapAuditsToRows(audits []accountcmdutil.AccountAudit, localizer localize.Localizer) []auditRow {
+	rows := make([]auditRow, len(audits))
+
+	for i, a := range audits {
+		var findings []string
+		if a.Orphaned {
+			findings = append(findings, localizer.MustLocalize("serviceAccount.audit.finding.orphaned"))
+		}
+		if a.ClusterAdmin {
+			findings = append(findings, localizer.MustLocalize("serviceAccount.audit.finding.clusterAdmin"))
+		}
+
+		rows[i] = auditRow{
+			ID:            a.ID,
+			ClientID:      a.ClientID,
+			Name:          a.Name,
+			KafkaBindings: len(a.KafkaBindings),
+			RegistryRoles: len(a.RegistryRoles),
+			Findings:      strings.Join(findings, ", "),
+		}
+	}
+
+	return rows
+}
@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/v1alpha"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
-	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
@@ -36,6 +37,10 @@ type options struct {
 	overwrite        bool
 	shortDescription string
 	filename         string
+	labels           []string
+	expiresAt        string
+
+	sink accountcmdutil.CredentialsSink
 
 	interactive bool
 }
@@ -64,12 +69,12 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 				opts.interactive = true
 			}
 
-			if !opts.interactive {
-				validator := &validation.Validator{
-					Localizer: opts.localizer,
-				}
+			validator := &validation.Validator{
+				Localizer: opts.localizer,
+			}
 
-				if opts.fileFormat == "" {
+			if !opts.interactive {
+				if opts.fileFormat == "" && opts.sink.NeedsFileFormat() {
 					return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "file-format"))
 				}
 
@@ -78,6 +83,18 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 				}
 			}
 
+			if err = accountcmdutil.ValidateLabelFlags(validator, opts.labels, opts.expiresAt); err != nil {
+				return err
+			}
+
+			if err = opts.sink.Validate(); err != nil {
+				return err
+			}
+
+			if err = opts.sink.ValidateFileFlags(cmd, opts.localizer); err != nil {
+				return err
+			}
+
 			// check that a valid --file-format flag value is used
 			validOutput := flagutil.IsValidInput(opts.fileFormat, flagutil.CredentialsOutputFormats...)
 			if !validOutput && opts.fileFormat != "" {
@@ -92,6 +109,10 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, opts.localizer.MustLocalize("serviceAccount.common.flag.overwrite.description"))
 	cmd.Flags().StringVar(&opts.filename, "output-file", "", opts.localizer.MustLocalize("serviceAccount.common.flag.fileLocation.description"))
 	cmd.Flags().StringVar(&opts.fileFormat, "file-format", "", opts.localizer.MustLocalize("serviceAccount.common.flag.fileFormat.description"))
+	cmd.Flags().StringArrayVar(&opts.labels, "label", []string{}, opts.localizer.MustLocalize("serviceAccount.common.flag.label.description"))
+	cmd.Flags().StringVar(&opts.expiresAt, "expires-at", "", opts.localizer.MustLocalize("serviceAccount.common.flag.expiresAt.description"))
+
+	accountcmdutil.AddCredentialsSinkFlags(cmd, opts.localizer, &opts.sink)
 
 	flagutil.EnableStaticFlagCompletion(cmd, "file-format", flagutil.CredentialsOutputFormats)
 
@@ -115,7 +136,7 @@ func runCreate(opts *options) error {
 		if err != nil {
 			return err
 		}
-	} else if opts.filename == "" {
+	} else if opts.filename == "" && opts.sink.IsFile() {
 		// obtain the absolute path to where credentials will be saved
 		opts.filename = credentials.GetDefaultPath(opts.fileFormat)
 	}
@@ -123,15 +144,48 @@ func runCreate(opts *options) error {
 	// If the credentials file already exists, and the --overwrite flag is not set then return an error
 	// indicating that the user should explicitly request overwriting of the file
 	_, err = os.Stat(opts.filename)
-	if err == nil && !opts.overwrite {
+	if err == nil && !opts.overwrite && opts.sink.IsFile() {
 		return opts.localizer.MustLocalizeError("serviceAccount.common.error.credentialsFileAlreadyExists", localize.NewEntry("FilePath", opts.filename))
 	}
 
+	// the labels are stored in the description of the service account
+	labels := accountcmdutil.LabelsFromFlags(opts.labels)
+	if opts.expiresAt != "" {
+		labels[accountcmdutil.ExpiresAtLabel] = opts.expiresAt
+	}
+
+	serviceAccountPayload := kafkamgmtclient.ServiceAccountRequest{Name: opts.shortDescription}
+	if len(labels) > 0 {
+		description := accountcmdutil.FormatLabels(labels)
+
+		validator := &validation.Validator{
+			Localizer: opts.localizer,
+		}
+		if err = validator.ValidateLabelsLength(description); err != nil {
+			return err
+		}
+
+		serviceAccountPayload.SetDescription(description)
+	}
+
+	// check the sink before the credentials are issued, since the client secret cannot be retrieved again
+	opts.sink.Overwrite = opts.overwrite
+	env := &v1alpha.CommandEnvironment{
+		IO:         opts.IO,
+		Logger:     opts.Logger,
+		Localizer:  opts.localizer,
+		Config:     opts.Config,
+		Connection: conn,
+		Context:    opts.Context,
+	}
+	if err = opts.sink.Prepare(env, ""); err != nil {
+		return err
+	}
+
 	spinner := spinner.New(opts.IO.ErrOut, opts.localizer)
 	spinner.SetSuffix(opts.localizer.MustLocalize("serviceAccount.create.log.info.creating"))
 	spinner.Start()
 	// create the service account
-	serviceAccountPayload := kafkamgmtclient.ServiceAccountRequest{Name: opts.shortDescription}
 
 	serviceacct, httpRes, err := conn.API().
 		ServiceAccountMgmt().
@@ -156,18 +210,16 @@ func runCreate(opts *options) error {
 		TokenURL:     cfg.MasAuthURL + "/protocol/openid-connect/token",
 	}
 
-	// save the credentials to a file
-	err = credentials.Write(opts.fileFormat, opts.filename, creds)
-	if err != nil {
+	opts.sink.FileFormat = opts.fileFormat
+	opts.sink.FilePath = opts.filename
+
+	// save the credentials to the selected sink
+	err = opts.sink.Save(env, creds)
+	if err != nil && opts.sink.IsFile() {
 		return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("serviceAccount.common.error.couldNotSaveCredentialsFile"), err)
 	}
 
-	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.common.log.info.credentialsSaved",
-		localize.NewEntry("FilePath", color.CodeSnippet(opts.filename)),
-		localize.NewEntry("ClientID", color.Success(creds.ClientID)),
-	))
-
-	return nil
+	return err
 }
 
 func runInteractivePrompt(opts *options) (err error) {
@@ -193,7 +245,7 @@ func runInteractivePrompt(opts *options) (err error) {
 	}
 
 	// if the --file-format flag was not used, ask in the prompt
-	if opts.fileFormat == "" {
+	if opts.fileFormat == "" && opts.sink.NeedsFileFormat() {
 		opts.Logger.Debug(opts.localizer.MustLocalize("serviceAccount.common.log.debug.interactive.fileFormatNotSet"))
 
 		fileFormatPrompt := &survey.Select{
@@ -209,6 +261,10 @@ func runInteractivePrompt(opts *options) (err error) {
 		}
 	}
 
+	if !opts.sink.IsFile() {
+		return nil
+	}
+
 	opts.filename, opts.overwrite, err = credentials.ChooseFileLocation(opts.fileFormat, opts.filename, opts.overwrite)
 	if err != nil {
 		return err
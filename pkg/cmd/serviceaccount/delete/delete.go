@@ -3,17 +3,25 @@ package delete
 import (
 	"context"
 	"net/http"
+	"strings"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 )
@@ -26,8 +34,18 @@ type options struct {
 	localizer  localize.Localizer
 	Context    context.Context
 
-	id    string
-	force bool
+	id          string
+	skipConfirm bool
+	cascade     bool
+	force       bool
+}
+
+// dependentRow contains the properties used to
+// populate the resources removed with the service account into a table row
+type dependentRow struct {
+	Service     string `header:"Service"`
+	Instance    string `header:"Instance"`
+	Description string `header:"Description"`
 }
 
 // NewDeleteCommand creates a new command to delete a service account
@@ -48,7 +66,7 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		Example: opts.localizer.MustLocalize("serviceAccount.delete.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			if !opts.IO.CanPrompt() && !opts.force {
+			if !opts.IO.CanPrompt() && !opts.skipConfirm {
 				return flagutil.RequiredWhenNonInteractiveError("yes")
 			}
 
@@ -61,25 +79,32 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 				return validID
 			}
 
+			if opts.force && !opts.cascade {
+				return opts.localizer.MustLocalizeError("serviceAccount.delete.error.forceRequiresCascade")
+			}
+
 			return runDelete(opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.delete.flag.id.description"))
-	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("serviceAccount.delete.flag.yes.description"))
+	cmd.Flags().BoolVarP(&opts.skipConfirm, "yes", "y", false, opts.localizer.MustLocalize("serviceAccount.delete.flag.yes.description"))
+	cmd.Flags().BoolVar(&opts.cascade, "cascade", false, opts.localizer.MustLocalize("serviceAccount.delete.flag.cascade.description"))
+	cmd.Flags().BoolVar(&opts.force, "force", false, opts.localizer.MustLocalize("serviceAccount.delete.flag.force.description"))
 
 	_ = cmd.MarkFlagRequired("id")
 
 	return cmd
 }
 
+// nolint:funlen
 func runDelete(opts *options) (err error) {
 	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
 	if err != nil {
 		return err
 	}
 
-	_, httpRes, err := conn.API().ServiceAccountMgmt().GetServiceAccountById(opts.Context, opts.id).Execute()
+	serviceAccount, httpRes, err := conn.API().ServiceAccountMgmt().GetServiceAccountById(opts.Context, opts.id).Execute()
 	if httpRes != nil {
 		defer httpRes.Body.Close()
 	}
@@ -93,10 +118,23 @@ func runDelete(opts *options) (err error) {
 		}
 	}
 
-	if !opts.force {
+	var dependents *accountcmdutil.AccountAudit
+	if opts.cascade {
+		dependents, err = findDependents(opts, serviceAccount.GetClientId())
+		if err != nil {
+			return err
+		}
+	}
+
+	if !opts.skipConfirm {
+		confirmMessage := opts.localizer.MustLocalize("serviceAccount.delete.input.confirmDelete.message", localize.NewEntry("ID", opts.id))
+		if dependents != nil {
+			confirmMessage = opts.localizer.MustLocalize("serviceAccount.delete.input.confirmCascadeDelete.message", localize.NewEntry("ID", opts.id))
+		}
+
 		var confirmDelete bool
 		promptConfirmDelete := &survey.Confirm{
-			Message: opts.localizer.MustLocalize("serviceAccount.delete.input.confirmDelete.message", localize.NewEntry("ID", opts.id)),
+			Message: confirmMessage,
 		}
 
 		err = survey.AskOne(promptConfirmDelete, &confirmDelete)
@@ -110,9 +148,133 @@ func runDelete(opts *options) (err error) {
 		}
 	}
 
+	if dependents != nil {
+		if err = deleteDependents(opts, dependents); err != nil {
+			return err
+		}
+	}
+
 	return deleteServiceAccount(opts)
 }
 
+// findDependents finds the ACL bindings and Service Registry role mappings of the service account,
+// and prints a preview of them. It returns nil when the service account has none.
+// When instances cannot be inspected, their dependents cannot be deleted, so it fails unless --force is used
+func findDependents(opts *options, clientID string) (*accountcmdutil.AccountAudit, error) {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	spinnr := spinner.New(opts.IO.ErrOut, opts.localizer)
+	spinnr.SetLocalizedSuffix("serviceAccount.delete.log.info.findingDependents")
+	spinnr.Start()
+
+	usage, err := accountcmdutil.CollectInstancesUsage(opts.Context, conn.API(), opts.localizer, opts.Logger)
+	spinnr.Stop()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usage.Skipped) > 0 {
+		if !opts.force {
+			return nil, opts.localizer.MustLocalizeError("serviceAccount.delete.error.instancesSkipped",
+				localize.NewEntry("Instances", strings.Join(usage.Skipped, ", ")),
+			)
+		}
+		opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalizePlural("serviceAccount.delete.log.info.instancesSkipped", len(usage.Skipped),
+			localize.NewEntry("Instances", strings.Join(usage.Skipped, ", ")),
+		))
+	}
+
+	account := kafkamgmtclient.ServiceAccountListItem{}
+	account.SetId(opts.id)
+	account.SetClientId(clientID)
+
+	dependents := &accountcmdutil.AuditAccounts([]kafkamgmtclient.ServiceAccountListItem{account}, usage.KafkaACLs, usage.RegistryRoles, usage.Skipped)[0]
+
+	if len(dependents.KafkaBindings) == 0 && len(dependents.RegistryRoles) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("serviceAccount.delete.log.info.noDependents"))
+		return nil, nil
+	}
+
+	rows := make([]dependentRow, 0, len(dependents.KafkaBindings)+len(dependents.RegistryRoles))
+	for _, b := range dependents.KafkaBindings {
+		rows = append(rows, dependentRow{Service: "Kafka", Instance: b.InstanceName, Description: b.Description()})
+	}
+	for _, r := range dependents.RegistryRoles {
+		rows = append(rows, dependentRow{Service: "Service Registry", Instance: r.InstanceName, Description: r.Role})
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("serviceAccount.delete.log.info.dependentsPreview", len(rows)))
+	opts.Logger.Info()
+	dump.Table(opts.IO.Out, rows)
+	opts.Logger.Info()
+
+	return dependents, nil
+}
+
+// deleteDependents deletes the ACL bindings of the service account with the ACL delete filters,
+// and its Service Registry role mappings
+func deleteDependents(opts *options, dependents *accountcmdutil.AccountAudit) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	deletedInstances := map[string]bool{}
+	for _, b := range dependents.KafkaBindings {
+		if deletedInstances[b.InstanceID] {
+			continue
+		}
+		deletedInstances[b.InstanceID] = true
+
+		adminAPI, _, err := api.KafkaAdmin(b.InstanceID)
+		if err != nil {
+			return err
+		}
+
+		deletedACLs, httpRes, err := aclcmdutil.PrincipalDeleteRequest(opts.Context, adminAPI, dependents.ClientID).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err = aclcmdutil.ValidateAPIError(httpRes, opts.localizer, err, "delete", b.InstanceName); err != nil {
+			return err
+		}
+
+		deletedCount := int(deletedACLs.GetTotal())
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalizePlural("kafka.acl.delete.successMessage",
+			deletedCount,
+			localize.NewEntry("Name", b.InstanceName),
+			localize.NewEntry("Count", deletedCount),
+		))
+	}
+
+	for _, r := range dependents.RegistryRoles {
+		dataAPI, _, err := api.ServiceRegistryInstance(r.InstanceID)
+		if err != nil {
+			return err
+		}
+
+		httpRes, err := dataAPI.AdminApi.DeleteRoleMapping(opts.Context, dependents.ClientID).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return registrycmdutil.TransformInstanceError(err)
+		}
+
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.delete.log.info.roleMappingDeleted",
+			localize.NewEntry("Role", r.Role),
+			localize.NewEntry("Instance", r.InstanceName),
+		))
+	}
+
+	return nil
+}
+
 func deleteServiceAccount(opts *options) error {
 	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
 	if err != nil {
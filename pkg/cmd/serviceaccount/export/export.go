@@ -0,0 +1,131 @@
+package export
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+
+	"github.com/spf13/cobra"
+)
+
+var validOutputFormats = append(append([]string{}, flagutil.ValidOutputFormats...), accountcmdutil.CSVFormat)
+
+type options struct {
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	IO         *iostreams.IOStreams
+	localizer  localize.Localizer
+	Context    context.Context
+
+	output string
+	file   string
+	labels []string
+}
+
+// NewExportCommand creates a new command to export the inventory of service accounts
+func NewExportCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   opts.localizer.MustLocalize("serviceAccount.export.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("serviceAccount.export.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("serviceAccount.export.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !flagutil.IsValidInput(opts.output, validOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.output, validOutputFormats...)
+			}
+
+			validator := &validation.Validator{
+				Localizer: opts.localizer,
+			}
+
+			if err := accountcmdutil.ValidateLabelFlags(validator, opts.labels, ""); err != nil {
+				return err
+			}
+
+			return runExport(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.output, "output", "o", dump.JSONFormat, flagutil.FlagDescription(opts.localizer, "serviceAccount.export.flag.output.description", validOutputFormats...))
+	cmd.Flags().StringVar(&opts.file, "output-file", "", opts.localizer.MustLocalize("serviceAccount.export.flag.outputFile.description"))
+	cmd.Flags().StringArrayVar(&opts.labels, "label", []string{}, opts.localizer.MustLocalize("serviceAccount.common.flag.labelSelector.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output", validOutputFormats)
+
+	return cmd
+}
+
+func runExport(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	res, httpRes, err := conn.API().ServiceAccountMgmt().GetServiceAccounts(opts.Context).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	accounts := accountcmdutil.FilterAccountsByLabels(res.GetItems(), accountcmdutil.LabelsFromFlags(opts.labels))
+	records := accountcmdutil.BuildInventory(accounts, cfg.CredentialsResets, time.Now())
+
+	if opts.file == "" {
+		return writeInventory(opts.IO.Out, opts.output, records)
+	}
+
+	file, err := os.Create(opts.file)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err = writeInventory(file, opts.output, records); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalizePlural("serviceAccount.export.log.info.exported", len(records),
+		localize.NewEntry("Count", len(records)),
+		localize.NewEntry("FilePath", opts.file),
+	))
+
+	return nil
+}
+
+func writeInventory(w io.Writer, format string, records []accountcmdutil.InventoryRecord) error {
+	if format == accountcmdutil.CSVFormat {
+		return accountcmdutil.WriteInventoryCSV(w, records)
+	}
+	return dump.Formatted(w, format, records)
+}
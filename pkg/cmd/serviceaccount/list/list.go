@@ -2,7 +2,10 @@ package list
 
 import (
 	"context"
+	"time"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
@@ -23,7 +26,9 @@ type options struct {
 	localizer  localize.Localizer
 	Context    context.Context
 
-	output string
+	output  string
+	labels  []string
+	expired bool
 }
 
 // svcAcctRow contains the properties used to
@@ -34,6 +39,7 @@ type svcAcctRow struct {
 	Name      string `json:"name" header:"Short Description"`
 	Owner     string `json:"owner" header:"Owner"`
 	CreatedAt string `json:"createdAt" header:"Created At"`
+	Labels    string `json:"labels" header:"Labels"`
 }
 
 // NewListCommand creates a new command to list service accounts
@@ -58,11 +64,21 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 				return flagutil.InvalidValueError("output", opts.output, flagutil.ValidOutputFormats...)
 			}
 
+			validator := &validation.Validator{
+				Localizer: opts.localizer,
+			}
+
+			if err := accountcmdutil.ValidateLabelFlags(validator, opts.labels, ""); err != nil {
+				return err
+			}
+
 			return runList(opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.output, "output", "o", "", opts.localizer.MustLocalize("serviceAccount.list.flag.output.description"))
+	cmd.Flags().StringArrayVar(&opts.labels, "label", []string{}, opts.localizer.MustLocalize("serviceAccount.common.flag.labelSelector.description"))
+	cmd.Flags().BoolVar(&opts.expired, "expired", false, opts.localizer.MustLocalize("serviceAccount.list.flag.expired.description"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -80,7 +96,12 @@ func runList(opts *options) (err error) {
 		return err
 	}
 
-	serviceaccounts := res.GetItems()
+	serviceaccounts := accountcmdutil.FilterAccountsByLabels(res.GetItems(), accountcmdutil.LabelsFromFlags(opts.labels))
+	if opts.expired {
+		serviceaccounts = filterExpired(serviceaccounts, time.Now())
+	}
+	res.SetItems(serviceaccounts)
+
 	if len(serviceaccounts) == 0 && opts.output == "" {
 		opts.Logger.Info(opts.localizer.MustLocalize("serviceAccount.list.log.info.noneFound"))
 		return nil
@@ -108,6 +129,7 @@ func mapResponseItemsToRows(svcAccts []kafkamgmtclient.ServiceAccountListItem) [
 			ClientID:  sa.GetClientId(),
 			Owner:     sa.GetOwner(),
 			CreatedAt: sa.GetCreatedAt().String(),
+			Labels:    sa.GetDescription(),
 		}
 
 		rows[i] = row
@@ -115,3 +137,13 @@ func mapResponseItemsToRows(svcAccts []kafkamgmtclient.ServiceAccountListItem) [
 
 	return rows
 }
+
+func filterExpired(svcAccts []kafkamgmtclient.ServiceAccountListItem, now time.Time) []kafkamgmtclient.ServiceAccountListItem {
+	expired := []kafkamgmtclient.ServiceAccountListItem{}
+	for _, sa := range svcAccts {
+		if accountcmdutil.IsExpired(accountcmdutil.ParseLabels(sa.GetDescription()), now) {
+			expired = append(expired, sa)
+		}
+	}
+	return expired
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/v1alpha"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
@@ -37,6 +40,8 @@ type options struct {
 	overwrite  bool
 	filename   string
 
+	sink accountcmdutil.CredentialsSink
+
 	interactive bool
 	force       bool
 }
@@ -64,10 +69,18 @@ func NewResetCredentialsCommand(f *factory.Factory) *cobra.Command {
 				opts.interactive = true
 			}
 
-			if !opts.interactive && opts.fileFormat == "" {
+			if !opts.interactive && opts.fileFormat == "" && opts.sink.NeedsFileFormat() {
 				return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "file-format"))
 			}
 
+			if err := opts.sink.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.sink.ValidateFileFlags(cmd, opts.localizer); err != nil {
+				return err
+			}
+
 			validOutput := flagutil.IsValidInput(opts.fileFormat, flagutil.CredentialsOutputFormats...)
 			if !validOutput && opts.fileFormat != "" {
 				return flagutil.InvalidValueError("file-format", opts.fileFormat, flagutil.CredentialsOutputFormats...)
@@ -94,6 +107,8 @@ func NewResetCredentialsCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.fileFormat, "file-format", "", opts.localizer.MustLocalize("serviceAccount.common.flag.fileFormat.description"))
 	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("serviceAccount.resetCredentials.flag.yes.description"))
 
+	accountcmdutil.AddCredentialsSinkFlags(cmd, opts.localizer, &opts.sink)
+
 	flagutil.EnableStaticFlagCompletion(cmd, "file-format", flagutil.CredentialsOutputFormats)
 
 	return cmd
@@ -108,30 +123,46 @@ func runResetCredentials(opts *options) (err error) {
 
 	api := conn.API()
 
-	_, httpRes, err := api.ServiceAccountMgmt().GetServiceAccountById(opts.Context, opts.id).Execute()
-	if httpRes != nil {
-		defer httpRes.Body.Close()
-	}
-
-	if err != nil {
-		return err
-	}
 	if opts.interactive {
 		err = runInteractivePrompt(opts)
 		if err != nil {
 			return err
 		}
-	} else if opts.filename == "" {
+	} else if opts.filename == "" && opts.sink.IsFile() {
 		// obtain the default absolute path to where credentials will be saved
 		opts.filename = credentials.GetDefaultPath(opts.fileFormat)
 	}
 
+	// the service account is fetched after the interactive prompt, which asks for its ID
+	serviceAccount, httpRes, err := api.ServiceAccountMgmt().GetServiceAccountById(opts.Context, opts.id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if err != nil {
+		return err
+	}
+
 	// If the credentials file already exists, and the --overwrite flag is not set then return an error
 	// indicating that the user should explicitly request overwriting of the file
-	if _, err = os.Stat(opts.filename); err == nil && !opts.overwrite {
+	if _, err = os.Stat(opts.filename); err == nil && !opts.overwrite && opts.sink.IsFile() {
 		return opts.localizer.MustLocalizeError("serviceAccount.common.error.credentialsFileAlreadyExists", localize.NewEntry("FilePath", color.CodeSnippet(opts.filename)))
 	}
 
+	// check the sink before the credentials are issued, since the client secret cannot be retrieved again
+	opts.sink.Overwrite = opts.overwrite
+	env := &v1alpha.CommandEnvironment{
+		IO:         opts.IO,
+		Logger:     opts.Logger,
+		Localizer:  opts.localizer,
+		Config:     opts.Config,
+		Connection: conn,
+		Context:    opts.Context,
+	}
+	if err = opts.sink.Prepare(env, serviceAccount.GetClientId()); err != nil {
+		return err
+	}
+
 	if !opts.force {
 		// prompt the user to confirm their wish to proceed with this action
 		var confirmReset bool
@@ -156,6 +187,11 @@ func runResetCredentials(opts *options) (err error) {
 
 	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.resetCredentials.log.info.resetSuccess", localize.NewEntry("ID", updatedServiceAccount.GetId())))
 
+	// the API does not return when the credentials were reset, which "service-account rotate --age-threshold" needs
+	if err = accountcmdutil.RecordCredentialsReset(opts.Config, updatedServiceAccount.GetId(), time.Now()); err != nil {
+		opts.Logger.Debug(err)
+	}
+
 	cfg, err := opts.Config.Load()
 	if err != nil {
 		return err
@@ -167,15 +203,16 @@ func runResetCredentials(opts *options) (err error) {
 		TokenURL:     cfg.MasAuthURL + "/protocol/openid-connect/token",
 	}
 
-	// save the credentials to a file
-	err = credentials.Write(opts.fileFormat, opts.filename, creds)
-	if err != nil {
-		return err
-	}
+	opts.sink.FileFormat = opts.fileFormat
+	opts.sink.FilePath = opts.filename
 
-	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.common.log.info.credentialsSaved", localize.NewEntry("FilePath", opts.filename)))
+	// save the credentials to the selected sink
+	err = opts.sink.Save(env, creds)
+	if err != nil && opts.sink.IsFile() {
+		return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("serviceAccount.common.error.couldNotSaveCredentialsFile"), err)
+	}
 
-	return nil
+	return err
 }
 
 func resetCredentials(opts *options) (*kafkamgmtclient.ServiceAccount, error) {
@@ -235,7 +272,7 @@ func runInteractivePrompt(opts *options) (err error) {
 	}
 
 	// if the --output flag was not used, ask in the prompt
-	if opts.fileFormat == "" {
+	if opts.fileFormat == "" && opts.sink.NeedsFileFormat() {
 		opts.Logger.Debug(opts.localizer.MustLocalize("serviceAccount.common.log.debug.interactive.fileFormatNotSet"))
 
 		fileFormatPrompt := &survey.Select{
@@ -251,6 +288,10 @@ func runInteractivePrompt(opts *options) (err error) {
 		}
 	}
 
+	if !opts.sink.IsFile() {
+		return nil
+	}
+
 	opts.filename, opts.overwrite, err = credentials.ChooseFileLocation(opts.fileFormat, opts.filename, opts.overwrite)
 	if err != nil {
 		return err
@@ -0,0 +1,409 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cluster"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/constants"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/kubeclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/v1alpha"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccountutil/credentials"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	// Get all auth schemes
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+)
+
+type options struct {
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+
+	id           string
+	ageThreshold int
+	fileFormat   string
+	filename     string
+	outputDir    string
+	overwrite    bool
+
+	updateSecret bool
+	namespace    string
+	kubeconfig   string
+
+	force bool
+}
+
+// rotateRow contains the properties used to
+// populate the service accounts to rotate into a table row
+type rotateRow struct {
+	ID       string `json:"id" header:"ID"`
+	ClientID string `json:"clientID" header:"Client ID"`
+	Name     string `json:"name" header:"Short Description"`
+	Age      string `json:"age" header:"Credentials Age"`
+}
+
+// NewRotateCommand creates a new command to rotate the credentials of service accounts
+func NewRotateCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "rotate",
+		Short:   opts.localizer.MustLocalize("serviceAccount.rotate.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("serviceAccount.rotate.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("serviceAccount.rotate.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !opts.IO.CanPrompt() && !opts.force {
+				return flagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if err := validateFlagInputCombination(opts); err != nil {
+				return err
+			}
+
+			if !flagutil.IsValidInput(opts.fileFormat, flagutil.CredentialsOutputFormats...) {
+				return flagutil.InvalidValueError("file-format", opts.fileFormat, flagutil.CredentialsOutputFormats...)
+			}
+
+			if opts.id != "" {
+				validator := &validation.Validator{
+					Localizer: opts.localizer,
+				}
+
+				if err := validator.ValidateUUID(opts.id); err != nil {
+					return err
+				}
+			}
+
+			return runRotate(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.rotate.flag.id.description"))
+	cmd.Flags().IntVar(&opts.ageThreshold, "age-threshold", 0, opts.localizer.MustLocalize("serviceAccount.rotate.flag.ageThreshold.description"))
+	cmd.Flags().StringVar(&opts.fileFormat, "file-format", credentials.EnvFormat, opts.localizer.MustLocalize("serviceAccount.common.flag.fileFormat.description"))
+	cmd.Flags().StringVar(&opts.filename, "output-file", "", opts.localizer.MustLocalize("serviceAccount.common.flag.fileLocation.description"))
+	cmd.Flags().StringVar(&opts.outputDir, "output-dir", "", opts.localizer.MustLocalize("serviceAccount.rotate.flag.outputDir.description"))
+	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, opts.localizer.MustLocalize("serviceAccount.common.flag.overwrite.description"))
+	cmd.Flags().BoolVar(&opts.updateSecret, "update-secret", false, opts.localizer.MustLocalize("serviceAccount.rotate.flag.updateSecret.description", localize.NewEntry("Name", constants.ServiceAccountSecretName)))
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", opts.localizer.MustLocalize("cluster.common.flag.namespace.description"))
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", opts.localizer.MustLocalize("cluster.common.flag.kubeconfig.description"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("serviceAccount.rotate.flag.yes.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "file-format", flagutil.CredentialsOutputFormats)
+
+	return cmd
+}
+
+func validateFlagInputCombination(opts *options) error {
+	if opts.id == "" && opts.ageThreshold == 0 {
+		return opts.localizer.MustLocalizeError("serviceAccount.rotate.error.noAccountsSelected")
+	}
+
+	if opts.id != "" && opts.ageThreshold != 0 {
+		return opts.localizer.MustLocalizeError("serviceAccount.rotate.error.idAndAgeThreshold")
+	}
+
+	if opts.ageThreshold < 0 {
+		return flagutil.InvalidValueError("age-threshold", opts.ageThreshold)
+	}
+
+	if opts.id != "" && opts.outputDir != "" {
+		return opts.localizer.MustLocalizeError("serviceAccount.rotate.error.flagRequiresAgeThreshold", localize.NewEntry("Flag", "output-dir"))
+	}
+
+	if opts.ageThreshold != 0 && opts.filename != "" {
+		return opts.localizer.MustLocalizeError("serviceAccount.rotate.error.flagRequiresID", localize.NewEntry("Flag", "output-file"))
+	}
+
+	if !opts.updateSecret && (opts.namespace != "" || opts.kubeconfig != "") {
+		return opts.localizer.MustLocalizeError("serviceAccount.rotate.error.flagRequiresUpdateSecret")
+	}
+
+	return nil
+}
+
+// nolint:funlen
+func runRotate(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	var accounts []kafkamgmtclient.ServiceAccountListItem
+	if opts.id != "" {
+		serviceAccount, httpRes, err := api.ServiceAccountMgmt().GetServiceAccountById(opts.Context, opts.id).Execute()
+		if httpRes != nil {
+			defer httpRes.Body.Close()
+		}
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				return opts.localizer.MustLocalizeError("serviceAccount.common.error.notFoundError", localize.NewEntry("ID", opts.id))
+			}
+			return err
+		}
+
+		accounts = append(accounts, kafkamgmtclient.ServiceAccountListItem{
+			Id:        serviceAccount.Id,
+			ClientId:  serviceAccount.ClientId,
+			Name:      serviceAccount.Name,
+			CreatedAt: serviceAccount.CreatedAt,
+		})
+
+		if opts.filename == "" {
+			opts.filename = credentials.GetDefaultPath(opts.fileFormat)
+		}
+	} else {
+		res, httpRes, err := api.ServiceAccountMgmt().GetServiceAccounts(opts.Context).Execute()
+		if httpRes != nil {
+			defer httpRes.Body.Close()
+		}
+		if err != nil {
+			return err
+		}
+
+		cfg, err := opts.Config.Load()
+		if err != nil {
+			return err
+		}
+
+		threshold := time.Duration(opts.ageThreshold) * 24 * time.Hour
+		now := time.Now()
+		accounts = accountcmdutil.AccountsWithCredentialsOlderThan(res.GetItems(), cfg.CredentialsResets, threshold, now)
+
+		if len(accounts) == 0 {
+			opts.Logger.Info(opts.localizer.MustLocalizePlural("serviceAccount.rotate.log.info.noneExpired", opts.ageThreshold, localize.NewEntry("Days", opts.ageThreshold)))
+			return nil
+		}
+
+		opts.Logger.Info(opts.localizer.MustLocalizePlural("serviceAccount.rotate.log.info.expiredPreview", len(accounts), localize.NewEntry("Days", opts.ageThreshold)))
+		opts.Logger.Info()
+		dump.Table(opts.IO.Out, mapAccountsToRows(accounts, cfg.CredentialsResets, now))
+		opts.Logger.Info()
+		opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalize("serviceAccount.rotate.log.info.localCredentialsAge"))
+		opts.Logger.Info()
+	}
+
+	// If a credentials file already exists, and the --overwrite flag is not set then return an error
+	// before any credentials are reset
+	if !opts.overwrite {
+		for i := range accounts {
+			path := credentialsPath(opts, &accounts[i])
+			if _, err = os.Stat(path); err == nil {
+				return opts.localizer.MustLocalizeError("serviceAccount.common.error.credentialsFileAlreadyExists", localize.NewEntry("FilePath", color.CodeSnippet(path)))
+			}
+		}
+	}
+
+	// create the Kubernetes clients before resetting the credentials, so that an invalid
+	// cluster configuration does not leave the secret with outdated credentials
+	var clusterAPI *cluster.KubernetesClusterAPIImpl
+	if opts.updateSecret {
+		env := &v1alpha.CommandEnvironment{
+			IO:         opts.IO,
+			Logger:     opts.Logger,
+			Localizer:  opts.localizer,
+			Config:     opts.Config,
+			Connection: conn,
+			Context:    opts.Context,
+		}
+
+		kubeClients, err := kubeclient.NewKubernetesClusterClients(env, opts.kubeconfig)
+		if err != nil {
+			return err
+		}
+
+		clusterAPI = &cluster.KubernetesClusterAPIImpl{
+			KubernetesClients:  kubeClients,
+			CommandEnvironment: env,
+		}
+	}
+
+	if !opts.force {
+		var confirmRotate bool
+		promptConfirmRotate := &survey.Confirm{
+			Message: opts.localizer.MustLocalizePlural("serviceAccount.rotate.input.confirmRotate.message", len(accounts),
+				localize.NewEntry("ID", accounts[0].GetId()),
+				localize.NewEntry("Count", len(accounts)),
+			),
+		}
+
+		if err = survey.AskOne(promptConfirmRotate, &confirmRotate); err != nil {
+			return err
+		}
+		if !confirmRotate {
+			opts.Logger.Debug(opts.localizer.MustLocalize("serviceAccount.rotate.log.debug.cancelledRotate"))
+			return nil
+		}
+	}
+
+	var secretUpdated bool
+	var failedCount int
+	for i := range accounts {
+		updated, err := rotateAccount(opts, &accounts[i], clusterAPI)
+		if err != nil {
+			if opts.id != "" {
+				return err
+			}
+			opts.Logger.Info(icon.ErrorPrefix(), err)
+			failedCount++
+			continue
+		}
+		secretUpdated = secretUpdated || updated
+	}
+
+	if clusterAPI != nil && !secretUpdated {
+		opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalize("serviceAccount.rotate.log.info.secretNotUpdated", localize.NewEntry("Name", constants.ServiceAccountSecretName)))
+	}
+
+	if failedCount > 0 {
+		return opts.localizer.MustLocalizeError("serviceAccount.rotate.error.rotateFailed",
+			localize.NewEntry("Count", failedCount),
+			localize.NewEntry("Total", len(accounts)),
+		)
+	}
+
+	return nil
+}
+
+// rotateAccount resets the credentials of the service account, saves them to a file and updates
+// the Kubernetes secret when it holds the credentials of the service account
+func rotateAccount(opts *options, account *kafkamgmtclient.ServiceAccountListItem, clusterAPI *cluster.KubernetesClusterAPIImpl) (bool, error) {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return false, err
+	}
+
+	id := account.GetId()
+
+	opts.Logger.Debug(opts.localizer.MustLocalize("serviceAccount.resetCredentials.log.debug.resettingCredentials", localize.NewEntry("ID", id)))
+
+	serviceAccount, httpRes, err := conn.API().ServiceAccountMgmt().ResetServiceAccountCreds(opts.Context, id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		resetErr := opts.localizer.MustLocalize("serviceAccount.resetCredentials.error.resetError", localize.NewEntry("ID", id))
+		if httpRes != nil && httpRes.StatusCode == http.StatusForbidden {
+			return false, fmt.Errorf("%v: %w", resetErr, opts.localizer.MustLocalizeError("serviceAccount.common.error.forbidden", localize.NewEntry("Operation", "update")))
+		}
+		return false, fmt.Errorf("%v: %w", resetErr, err)
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.resetCredentials.log.info.resetSuccess", localize.NewEntry("ID", id)))
+
+	if err = accountcmdutil.RecordCredentialsReset(opts.Config, id, time.Now()); err != nil {
+		opts.Logger.Debug(err)
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return false, err
+	}
+
+	creds := &credentials.Credentials{
+		ClientID:     serviceAccount.GetClientId(),
+		ClientSecret: serviceAccount.GetClientSecret(),
+		TokenURL:     cfg.MasAuthURL + "/protocol/openid-connect/token",
+	}
+
+	path := credentialsPath(opts, account)
+	if err = credentials.Write(opts.fileFormat, path, creds); err != nil {
+		return false, err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.common.log.info.credentialsSaved", localize.NewEntry("FilePath", path)))
+
+	if clusterAPI == nil {
+		return false, nil
+	}
+
+	result, err := clusterAPI.ExecuteRotateSecret(&v1alpha.RotateSecretOperationOptions{
+		Namespace:    opts.namespace,
+		ClientID:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !result.Updated {
+		return false, nil
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.rotate.log.info.secretUpdated",
+		localize.NewEntry("Name", constants.ServiceAccountSecretName),
+		localize.NewEntry("Namespace", result.Namespace),
+	))
+
+	if len(result.Consumers) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("serviceAccount.rotate.log.info.noConsumers"))
+	} else {
+		opts.Logger.Info(opts.localizer.MustLocalizePlural("serviceAccount.rotate.log.info.consumersUpdated", len(result.Consumers),
+			localize.NewEntry("Consumers", strings.Join(result.Consumers, ", ")),
+		))
+	}
+
+	return true, nil
+}
+
+// credentialsPath returns the file to which the credentials of the service account are saved.
+// When several service accounts are rotated, each file is named after the client ID
+func credentialsPath(opts *options, account *kafkamgmtclient.ServiceAccountListItem) string {
+	if opts.id != "" {
+		return opts.filename
+	}
+
+	ext := filepath.Ext(credentials.GetDefaultPath(opts.fileFormat))
+	return filepath.Join(opts.outputDir, account.GetClientId()+ext)
+}
+
+func mapAccountsToRows(accounts []kafkamgmtclient.ServiceAccountListItem, resets map[string]time.Time, now time.Time) []rotateRow {
+	rows := make([]rotateRow, len(accounts))
+
+	for i := range accounts {
+		age := accountcmdutil.CredentialsAge(&accounts[i], resets, now)
+		rows[i] = rotateRow{
+			ID:       accounts[i].GetId(),
+			ClientID: accounts[i].GetClientId(),
+			Name:     accounts[i].GetName(),
+			Age:      fmt.Sprintf("%dd", int(age.Hours()/24)),
+		}
+	}
+
+	return rows
+}
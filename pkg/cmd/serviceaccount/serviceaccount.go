@@ -2,11 +2,15 @@ package serviceaccount
 
 import (
 	"github.com/redhat-developer/app-services-cli/internal/doc"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/audit"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/describe"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/export"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/list"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/resetcredentials"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/rotate"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/update"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
 	"github.com/spf13/cobra"
 )
@@ -28,6 +32,10 @@ func NewServiceAccountCommand(f *factory.Factory) *cobra.Command {
 		delete.NewDeleteCommand(f),
 		resetcredentials.NewResetCredentialsCommand(f),
 		describe.NewDescribeCommand(f),
+		rotate.NewRotateCommand(f),
+		audit.NewAuditCommand(f),
+		export.NewExportCommand(f),
+		update.NewUpdateCommand(f),
 	)
 
 	return cmd
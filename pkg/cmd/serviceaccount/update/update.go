@@ -0,0 +1,177 @@
+package update
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/serviceaccount"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/accountcmdutil/validation"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	id               string
+	shortDescription string
+	labels           []string
+	removeLabels     []string
+	expiresAt        string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewUpdateCommand creates a new command to update the short description and labels of a service account
+func NewUpdateCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "update",
+		Short:   opts.localizer.MustLocalize("serviceAccount.update.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("serviceAccount.update.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("serviceAccount.update.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			validator := &validation.Validator{
+				Localizer: opts.localizer,
+			}
+
+			if err := validator.ValidateUUID(opts.id); err != nil {
+				return err
+			}
+
+			if opts.shortDescription != "" {
+				if err := validator.ValidateShortDescription(opts.shortDescription); err != nil {
+					return err
+				}
+			}
+
+			if err := accountcmdutil.ValidateLabelFlags(validator, opts.labels, opts.expiresAt); err != nil {
+				return err
+			}
+
+			return runUpdate(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.update.flag.id.description"))
+	cmd.Flags().StringVar(&opts.shortDescription, "short-description", "", opts.localizer.MustLocalize("serviceAccount.update.flag.shortDescription.description"))
+	cmd.Flags().StringArrayVar(&opts.labels, "label", []string{}, opts.localizer.MustLocalize("serviceAccount.common.flag.label.description"))
+	cmd.Flags().StringArrayVar(&opts.removeLabels, "remove-label", []string{}, opts.localizer.MustLocalize("serviceAccount.update.flag.removeLabel.description"))
+	cmd.Flags().StringVar(&opts.expiresAt, "expires-at", "", opts.localizer.MustLocalize("serviceAccount.common.flag.expiresAt.description"))
+
+	_ = cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func runUpdate(opts *options) error {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	current, httpRes, err := api.ServiceAccountMgmt().GetServiceAccountById(opts.Context, opts.id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return opts.localizer.MustLocalizeError("serviceAccount.common.error.notFoundError", localize.NewEntry("ID", opts.id))
+		}
+		return err
+	}
+
+	request, err := buildUpdateRequest(opts, &current)
+	if err != nil {
+		return err
+	}
+
+	_, httpRes, err = api.ServiceAccountUpdate().UpdateServiceAccount(opts.Context, opts.id, *request)
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		// the service account exists in the Kafka Management API, so it is not managed
+		// by the authentication server when the service account API does not find it
+		switch httpRes.StatusCode {
+		case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+			return opts.localizer.MustLocalizeError("serviceAccount.update.error.notSupported")
+		default:
+			return err
+		}
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.update.log.info.updateSuccess", localize.NewEntry("ID", opts.id)))
+
+	return nil
+}
+
+// buildUpdateRequest merges the flags with the current values of the service account
+func buildUpdateRequest(opts *options, current *kafkamgmtclient.ServiceAccount) (*serviceaccount.UpdateServiceAccountRequest, error) {
+	request := &serviceaccount.UpdateServiceAccountRequest{
+		Name:        current.GetName(),
+		Description: current.GetDescription(),
+	}
+	needsUpdate := false
+
+	if opts.shortDescription != "" && opts.shortDescription != current.GetName() {
+		request.Name = opts.shortDescription
+		needsUpdate = true
+	}
+
+	if len(opts.labels) > 0 || len(opts.removeLabels) > 0 || opts.expiresAt != "" {
+		labels := accountcmdutil.ParseLabels(current.GetDescription())
+		for key, value := range accountcmdutil.LabelsFromFlags(opts.labels) {
+			labels[key] = value
+		}
+		if opts.expiresAt != "" {
+			labels[accountcmdutil.ExpiresAtLabel] = opts.expiresAt
+		}
+		for _, key := range opts.removeLabels {
+			delete(labels, key)
+		}
+
+		description := accountcmdutil.FormatLabels(labels)
+		if description != current.GetDescription() {
+			validator := &validation.Validator{
+				Localizer: opts.localizer,
+			}
+			if err := validator.ValidateLabelsLength(description); err != nil {
+				return nil, err
+			}
+
+			request.Description = description
+			needsUpdate = true
+		}
+	}
+
+	if !needsUpdate {
+		return nil, opts.localizer.MustLocalizeError("serviceAccount.update.log.info.nothingToUpdate")
+	}
+
+	return request, nil
+}
@@ -0,0 +1,126 @@
+package bulkutil
+
+import (
+	"sync"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+)
+
+// MaxConcurrentRequests is the number of items which are processed at the same time by bulk operations
+const MaxConcurrentRequests = 5
+
+// Result is the outcome of a bulk operation on a single item
+type Result struct {
+	Name string
+	// Err is set when the operation failed
+	Err error
+	// Skipped is true when the operation was not attempted,
+	// because an operation on another item failed
+	Skipped bool
+}
+
+// Run runs an operation on each of the items, with at most MaxConcurrentRequests at the same time.
+// Unless continueOnError is set, no new operations are started after the first failure.
+// The results are returned in the order of the item names
+func Run(names []string, continueOnError bool, operation func(name string) error) []Result {
+	results := make([]Result, len(names))
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed bool
+	)
+
+	jobs := make(chan int)
+	for w := 0; w < MaxConcurrentRequests && w < len(names); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i].Name = names[i]
+
+				mu.Lock()
+				skip := failed && !continueOnError
+				mu.Unlock()
+
+				if skip {
+					results[i].Skipped = true
+					continue
+				}
+
+				if err := operation(names[i]); err != nil {
+					results[i].Err = err
+
+					mu.Lock()
+					failed = true
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	return results
+}
+
+// CountFailures returns the number of failed and skipped operations
+func CountFailures(results []Result) (failed int, skipped int) {
+	for _, result := range results {
+		if result.Skipped {
+			skipped++
+		} else if result.Err != nil {
+			failed++
+		}
+	}
+
+	return failed, skipped
+}
+
+// ResultRow is the table representation of the result of a bulk operation
+type ResultRow struct {
+	Name   string `json:"name" header:"Name"`
+	Status string `json:"status" header:"Status"`
+	Error  string `json:"error,omitempty" header:"Error"`
+}
+
+// MapResultsToTableRows creates the summary table of a bulk operation.
+// successStatus is the status of the items for which the operation succeeded
+func MapResultsToTableRows(results []Result, localizer localize.Localizer, successStatus string) []ResultRow {
+	rows := make([]ResultRow, 0, len(results))
+	for _, result := range results {
+		row := ResultRow{
+			Name:   result.Name,
+			Status: successStatus,
+		}
+		switch {
+		case result.Skipped:
+			row.Status = localizer.MustLocalize("common.bulk.status.skipped")
+		case result.Err != nil:
+			row.Status = localizer.MustLocalize("common.bulk.status.failed")
+			row.Error = result.Err.Error()
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// Confirm asks the user to confirm a bulk operation
+func Confirm(localizer localize.Localizer) (bool, error) {
+	var confirmed bool
+	prompt := &survey.Confirm{
+		Message: localizer.MustLocalize("common.bulk.input.confirm.message"),
+	}
+	if err := survey.AskOne(prompt, &confirmed); err != nil {
+		return false, err
+	}
+
+	return confirmed, nil
+}
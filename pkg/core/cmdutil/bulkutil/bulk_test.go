@@ -0,0 +1,52 @@
+package bulkutil
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	names := []string{"item-1", "item-2", "item-3", "item-4", "item-5", "item-6", "item-7", "item-8"}
+
+	t.Run("Should run the operation for all items", func(t *testing.T) {
+		var calls int32
+		results := Run(names, false, func(name string) error {
+			atomic.AddInt32(&calls, 1)
+			return nil
+		})
+
+		if calls != int32(len(names)) {
+			t.Errorf("Run() called the operation %v times, want %v", calls, len(names))
+		}
+		for i, result := range results {
+			if result.Name != names[i] || result.Err != nil || result.Skipped {
+				t.Errorf("Run() result %v = %+v", i, result)
+			}
+		}
+	})
+
+	t.Run("Should continue on error", func(t *testing.T) {
+		results := Run(names, true, func(name string) error {
+			if name == "item-1" {
+				return errors.New("failed")
+			}
+			return nil
+		})
+
+		if failed, skipped := CountFailures(results); failed != 1 || skipped != 0 {
+			t.Errorf("CountFailures() = %v, %v, want 1, 0", failed, skipped)
+		}
+	})
+
+	t.Run("Should skip the remaining items after an error", func(t *testing.T) {
+		results := Run(names, false, func(name string) error {
+			return errors.New("failed")
+		})
+
+		failed, skipped := CountFailures(results)
+		if failed == 0 || failed > MaxConcurrentRequests || failed+skipped != len(names) {
+			t.Errorf("CountFailures() = %v, %v", failed, skipped)
+		}
+	})
+}
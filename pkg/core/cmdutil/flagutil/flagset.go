@@ -13,7 +13,7 @@ import (
 
 var (
 	ValidOutputFormats       = []string{dump.JSONFormat, dump.YAMLFormat, dump.YMLFormat}
-	CredentialsOutputFormats = []string{credentials.EnvFormat, credentials.JSONFormat, credentials.PropertiesFormat}
+	CredentialsOutputFormats = credentials.FormatNames()
 )
 
 type FlagSet struct {
@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/redhat-developer/app-services-cli/pkg/api/rbac"
+	"github.com/redhat-developer/app-services-cli/pkg/api/serviceaccount"
 	amsclient "github.com/redhat-developer/app-services-sdk-go/accountmgmt/apiv1/client"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
@@ -13,6 +14,7 @@ type API interface {
 	KafkaMgmt() kafkamgmtclient.DefaultApi
 	ServiceRegistryMgmt() registrymgmtclient.RegistriesApi
 	ServiceAccountMgmt() kafkamgmtclient.SecurityApi
+	ServiceAccountUpdate() serviceaccount.UpdateAPI
 	KafkaAdmin(instanceID string) (*kafkainstanceclient.APIClient, *kafkamgmtclient.KafkaRequest, error)
 	ServiceRegistryInstance(instanceID string) (*registryinstanceclient.APIClient, *registrymgmtclient.Registry, error)
 	AccountMgmt() amsclient.AppServicesApi
@@ -13,6 +13,7 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/internal/build"
 	"github.com/redhat-developer/app-services-cli/pkg/api/rbac"
+	"github.com/redhat-developer/app-services-cli/pkg/api/serviceaccount"
 	"github.com/redhat-developer/app-services-cli/pkg/core/connection/api"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/svcstatus"
@@ -32,6 +33,7 @@ type defaultAPI struct {
 	AccessToken    string
 	MasAccessToken string
 	ApiURL         *url.URL
+	AuthURL        *url.URL
 	ConsoleURL     *url.URL
 	UserAgent      string
 	HTTPClient     *http.Client
@@ -42,6 +44,7 @@ type Config struct {
 	AccessToken    string
 	MasAccessToken string
 	ApiURL         *url.URL
+	AuthURL        *url.URL
 	ConsoleURL     *url.URL
 	UserAgent      string
 	HTTPClient     *http.Client
@@ -54,6 +57,7 @@ func New(cfg *Config) api.API {
 		AccessToken:    cfg.AccessToken,
 		MasAccessToken: cfg.MasAccessToken,
 		ApiURL:         cfg.ApiURL,
+		AuthURL:        cfg.AuthURL,
 		ConsoleURL:     cfg.ConsoleURL,
 		UserAgent:      cfg.UserAgent,
 		HTTPClient:     cfg.HTTPClient,
@@ -100,6 +104,16 @@ func (a *defaultAPI) ServiceAccountMgmt() kafkamgmtclient.SecurityApi {
 	return client.SecurityApi
 }
 
+// ServiceAccountUpdate returns a new API client instance to update service accounts,
+// using the service account API of the authentication server
+func (a *defaultAPI) ServiceAccountUpdate() serviceaccount.UpdateAPI {
+	return serviceaccount.NewUpdateAPIClient(&serviceaccount.Config{
+		HTTPClient: a.createOAuthTransport(a.AccessToken),
+		BaseURL:    a.AuthURL,
+		UserAgent:  a.UserAgent,
+	})
+}
+
 // KafkaAdmin returns a new Kafka Admin API client instance, with the Kafka configuration object
 func (a *defaultAPI) KafkaAdmin(instanceID string) (*kafkainstanceclient.APIClient, *kafkamgmtclient.KafkaRequest, error) {
 	kafkaAPI := a.KafkaMgmt()
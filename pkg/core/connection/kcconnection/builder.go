@@ -283,6 +283,7 @@ func (b *ConnectionBuilder) BuildContext(ctx context.Context) (connection *Conne
 		consoleURL:        consoleURL,
 		scopes:            scopes,
 		apiURL:            apiURL,
+		authURL:           authURL,
 		defaultHTTPClient: client,
 		keycloakClient:    keycloak,
 		masKeycloakClient: masKc,
@@ -36,6 +36,7 @@ type Connection struct {
 	keycloakClient    gocloak.GoCloak
 	masKeycloakClient gocloak.GoCloak
 	apiURL            *url.URL
+	authURL           *url.URL
 	consoleURL        *url.URL
 	defaultRealm      string
 	masRealm          string
@@ -148,6 +149,7 @@ func (c *Connection) API() api.API {
 		MasAccessToken: c.MASToken.AccessToken,
 		AccessToken:    c.Token.AccessToken,
 		ApiURL:         c.apiURL,
+		AuthURL:        c.authURL,
 		ConsoleURL:     c.consoleURL,
 		Logger:         c.logger,
 	})
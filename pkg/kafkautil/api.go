@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 )
 
+const listPageSize = 100
+
 func GetKafkaByID(ctx context.Context, api kafkamgmtclient.DefaultApi, id string) (*kafkamgmtclient.KafkaRequest, *http.Response, error) {
 	r := api.GetKafkaById(ctx, id)
 
@@ -36,3 +39,24 @@ func GetKafkaByName(ctx context.Context, api kafkamgmtclient.DefaultApi, name st
 
 	return &kafkaReq, httpResponse, err
 }
+
+// ListAllKafkas fetches all Kafka instances which the user can access
+func ListAllKafkas(ctx context.Context, api kafkamgmtclient.DefaultApi) ([]kafkamgmtclient.KafkaRequest, *http.Response, error) {
+	var kafkas []kafkamgmtclient.KafkaRequest
+	for page := 1; ; page++ {
+		kafkaList, httpResponse, err := api.GetKafkas(ctx).Page(strconv.Itoa(page)).Size(strconv.Itoa(listPageSize)).Execute()
+		if httpResponse != nil {
+			httpResponse.Body.Close()
+		}
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		items := kafkaList.GetItems()
+		kafkas = append(kafkas, items...)
+
+		if len(items) == 0 || len(kafkas) >= int(kafkaList.GetTotal()) {
+			return kafkas, httpResponse, nil
+		}
+	}
+}
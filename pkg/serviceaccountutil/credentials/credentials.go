@@ -1,21 +1,27 @@
 package credentials
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 )
 
 const (
-	EnvFormat        = "env"
-	JSONFormat       = "json"
-	PropertiesFormat = "properties"
+	EnvFormat              = "env"
+	JSONFormat             = "json"
+	PropertiesFormat       = "properties"
+	KubernetesSecretFormat = "kubernetes-secret"
+	YAMLFormat             = "yaml"
+	JavaJAASFormat         = "java-jaas"
+	LibrdkafkaFormat       = "librdkafka"
 )
 
 // Templates
@@ -52,13 +58,8 @@ type Credentials struct {
 
 // GetDefaultPath returns the default absolute path for the credentials file
 func GetDefaultPath(outputFormat string) (filePath string) {
-	switch outputFormat {
-	case EnvFormat:
-		filePath = ".env"
-	case PropertiesFormat:
-		filePath = "credentials.properties"
-	case JSONFormat:
-		filePath = "credentials.json"
+	if format, ok := GetFormat(outputFormat); ok {
+		filePath = format.DefaultFileName
 	}
 
 	pwd, err := os.Getwd()
@@ -74,11 +75,10 @@ func GetDefaultPath(outputFormat string) (filePath string) {
 // Write saves the credentials to a file
 // in the specified output format
 func Write(output string, filepath string, credentials *Credentials) error {
-	fileTemplate := getFileFormat(output)
-	fileBody := fmt.Sprintf(fileTemplate, credentials.ClientID,
-		credentials.ClientSecret, credentials.TokenURL)
-
-	fileData := []byte(fileBody)
+	fileData, err := Render(output, credentials)
+	if err != nil {
+		return err
+	}
 
 	// replace any env vars in the file path
 	trueFilePath := os.ExpandEnv(filepath)
@@ -86,17 +86,53 @@ func Write(output string, filepath string, credentials *Credentials) error {
 	return ioutil.WriteFile(trueFilePath, fileData, 0o600)
 }
 
-func getFileFormat(output string) (format string) {
-	switch output {
-	case EnvFormat:
-		format = templateEnv
-	case PropertiesFormat:
-		format = templateProperties
-	case JSONFormat:
-		format = templateJSON
+// Read loads the credentials from a file previously
+// saved in any of the supported output formats
+func Read(filePath string) (*Credentials, error) {
+	// #nosec G304
+	data, err := ioutil.ReadFile(os.ExpandEnv(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonCreds struct {
+		ClientID     string `json:"clientID"`
+		ClientSecret string `json:"clientSecret"`
+		TokenURL     string `json:"oauthTokenUrl"`
+	}
+
+	creds := &Credentials{}
+	if err = json.Unmarshal(data, &jsonCreds); err == nil {
+		creds.ClientID = jsonCreds.ClientID
+		creds.ClientSecret = jsonCreds.ClientSecret
+		creds.TokenURL = jsonCreds.TokenURL
+	} else {
+		// env and properties files share the same key=value layout
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			entry := strings.SplitN(line, "=", 2)
+			if len(entry) != 2 {
+				continue
+			}
+			switch strings.TrimSpace(entry[0]) {
+			case "RHOAS_SERVICE_ACCOUNT_CLIENT_ID", "rhoas.service-account.clientID":
+				creds.ClientID = strings.TrimSpace(entry[1])
+			case "RHOAS_SERVICE_ACCOUNT_CLIENT_SECRET", "rhoas.service-account.clientSecret":
+				creds.ClientSecret = strings.TrimSpace(entry[1])
+			case "RHOAS_SERVICE_ACCOUNT_OAUTH_TOKEN_URL", "rhoas.service-account.oauthTokenUrl":
+				creds.TokenURL = strings.TrimSpace(entry[1])
+			}
+		}
+	}
+
+	if creds.ClientID == "" || creds.ClientSecret == "" {
+		return nil, fmt.Errorf("no service account credentials found in file %v", filePath)
 	}
 
-	return format
+	return creds, nil
 }
 
 // ChooseFileLocation starts an interactive prompt to get the path to the credentials file
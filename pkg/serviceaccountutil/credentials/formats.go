@@ -0,0 +1,172 @@
+package credentials
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/redhat-developer/app-services-cli/pkg/cluster/constants"
+	"gopkg.in/yaml.v2"
+)
+
+// Format renders service account credentials in a file format
+type Format struct {
+	// Name is the value of the "--file-format" flag
+	Name string
+	// DefaultFileName is the name of the file in the current directory to which the credentials are saved by default
+	DefaultFileName string
+	// Render creates the content of the credentials file
+	Render func(credentials *Credentials) ([]byte, error)
+}
+
+var (
+	templateJavaJAAS = heredoc.Doc(`
+	// Generated by rhoas cli
+	KafkaClient {
+	    org.apache.kafka.common.security.oauthbearer.OAuthBearerLoginModule required
+	    oauth.client.id="%v"
+	    oauth.client.secret="%v"
+	    oauth.token.endpoint.uri="%v";
+	};
+	`)
+
+	templateLibrdkafka = heredoc.Doc(`
+	## Generated by rhoas cli
+	security.protocol=SASL_SSL
+	sasl.mechanisms=OAUTHBEARER
+	sasl.oauthbearer.method=oidc
+	sasl.oauthbearer.client.id=%v
+	sasl.oauthbearer.client.secret=%v
+	sasl.oauthbearer.token.endpoint.url=%v
+	`)
+)
+
+// formatRegistry contains the credentials formats, in the order in which they are offered to the user
+var formatRegistry = []*Format{
+	{Name: EnvFormat, DefaultFileName: ".env", Render: renderTemplate(templateEnv, nil)},
+	{Name: JSONFormat, DefaultFileName: "credentials.json", Render: renderTemplate(templateJSON, nil)},
+	{Name: PropertiesFormat, DefaultFileName: "credentials.properties", Render: renderTemplate(templateProperties, nil)},
+	{Name: YAMLFormat, DefaultFileName: "credentials.yaml", Render: renderYAML},
+	{Name: KubernetesSecretFormat, DefaultFileName: "credentials-secret.yaml", Render: renderKubernetesSecret},
+	{Name: JavaJAASFormat, DefaultFileName: "kafka_client_jaas.conf", Render: renderTemplate(templateJavaJAAS, escapeJAASValue)},
+	{Name: LibrdkafkaFormat, DefaultFileName: "librdkafka.conf", Render: renderTemplate(templateLibrdkafka, nil)},
+}
+
+// RegisterFormat adds a credentials format, or replaces the format with the same name
+func RegisterFormat(format *Format) {
+	for i, f := range formatRegistry {
+		if f.Name == format.Name {
+			formatRegistry[i] = format
+			return
+		}
+	}
+	formatRegistry = append(formatRegistry, format)
+}
+
+// GetFormat returns the credentials format with the name
+func GetFormat(name string) (*Format, bool) {
+	for _, f := range formatRegistry {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// FormatNames returns the names of the credentials formats
+func FormatNames() []string {
+	names := make([]string, len(formatRegistry))
+	for i, f := range formatRegistry {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// Render creates the content of a credentials file in the format
+func Render(formatName string, credentials *Credentials) ([]byte, error) {
+	format, ok := GetFormat(formatName)
+	if !ok {
+		return nil, fmt.Errorf("unsupported credentials format %q", formatName)
+	}
+	return format.Render(credentials)
+}
+
+// renderTemplate creates a function which fills the template with the client ID, client secret and token URL
+func renderTemplate(template string, escape func(string) string) func(*Credentials) ([]byte, error) {
+	return func(credentials *Credentials) ([]byte, error) {
+		values := []string{credentials.ClientID, credentials.ClientSecret, credentials.TokenURL}
+		args := make([]interface{}, len(values))
+		for i, v := range values {
+			if escape != nil {
+				v = escape(v)
+			}
+			args[i] = v
+		}
+		return []byte(fmt.Sprintf(template, args...)), nil
+	}
+}
+
+func escapeJAASValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
+
+func renderYAML(credentials *Credentials) ([]byte, error) {
+	type serviceAccount struct {
+		ClientID      string `yaml:"clientID"`
+		ClientSecret  string `yaml:"clientSecret"`
+		OauthTokenURL string `yaml:"oauthTokenUrl"`
+	}
+	type rhoas struct {
+		ServiceAccount serviceAccount `yaml:"service-account"`
+	}
+
+	data, err := yaml.Marshal(map[string]rhoas{
+		"rhoas": {ServiceAccount: serviceAccount{
+			ClientID:      credentials.ClientID,
+			ClientSecret:  credentials.ClientSecret,
+			OauthTokenURL: credentials.TokenURL,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("## Generated by rhoas cli\n"), data...), nil
+}
+
+// renderKubernetesSecret creates a manifest of the secret which the "cluster connect" command creates
+func renderKubernetesSecret(credentials *Credentials) ([]byte, error) {
+	type metadata struct {
+		Name string `yaml:"name"`
+	}
+	type secret struct {
+		APIVersion string            `yaml:"apiVersion"`
+		Kind       string            `yaml:"kind"`
+		Metadata   metadata          `yaml:"metadata"`
+		Type       string            `yaml:"type"`
+		StringData map[string]string `yaml:"stringData"`
+	}
+
+	data, err := yaml.Marshal(secret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata:   metadata{Name: constants.ServiceAccountSecretName},
+		Type:       "Opaque",
+		StringData: SecretData(credentials),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("## Generated by rhoas cli\n"), data...), nil
+}
+
+// SecretData returns the keys and values of a Kubernetes secret containing the credentials.
+// The keys of the client ID and secret are the ones used by the RHOAS operator
+func SecretData(credentials *Credentials) map[string]string {
+	return map[string]string{
+		"client-id":       credentials.ClientID,
+		"client-secret":   credentials.ClientSecret,
+		"oauth-token-url": credentials.TokenURL,
+	}
+}
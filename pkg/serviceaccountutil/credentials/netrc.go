@@ -0,0 +1,61 @@
+package credentials
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultNetrcPath returns the path of the .netrc file in the home directory of the user
+func DefaultNetrcPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".netrc"), nil
+}
+
+// WriteNetrc saves the credentials as a ".netrc" entry for the host of the token URL, so that tools
+// which support ".netrc" files can request tokens for the service account.
+// An entry on a single line for the same host and client ID is replaced, other entries are kept
+func WriteNetrc(filePath string, credentials *Credentials) error {
+	tokenURL, err := url.Parse(credentials.TokenURL)
+	if err != nil || tokenURL.Hostname() == "" {
+		return fmt.Errorf("invalid token URL %q", credentials.TokenURL)
+	}
+
+	filePath = os.ExpandEnv(filePath)
+
+	// #nosec G304
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	entry := fmt.Sprintf("machine %v login %v password %v", tokenURL.Hostname(), credentials.ClientID, credentials.ClientSecret)
+
+	var lines []string
+	if len(data) > 0 {
+		for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+			if !isNetrcEntry(line, tokenURL.Hostname(), credentials.ClientID) {
+				lines = append(lines, line)
+			}
+		}
+	}
+	lines = append(lines, entry)
+
+	return ioutil.WriteFile(filePath, []byte(strings.Join(lines, "\n")+"\n"), 0o600)
+}
+
+// isNetrcEntry checks if the line is a single line entry for the machine and login
+func isNetrcEntry(line string, machine string, login string) bool {
+	fields := strings.Fields(line)
+	values := map[string]string{}
+	for i := 0; i+1 < len(fields); i += 2 {
+		values[fields[i]] = fields[i+1]
+	}
+	return values["machine"] == machine && values["login"] == login
+}
@@ -0,0 +1,59 @@
+package credentials
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteNetrc(t *testing.T) {
+	creds := &Credentials{
+		ClientID:     "srvc-acct-1",
+		ClientSecret: "new-secret",
+		TokenURL:     "https://sso.redhat.com/auth/realms/redhat-external/protocol/openid-connect/token",
+	}
+
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{
+			name: "Should create the file when it does not exist",
+			want: "machine sso.redhat.com login srvc-acct-1 password new-secret\n",
+		},
+		{
+			name:     "Should replace the entry of the same client ID and keep other entries",
+			existing: "machine example.com login user password pass\nmachine sso.redhat.com login srvc-acct-1 password old-secret\n",
+			want:     "machine example.com login user password pass\nmachine sso.redhat.com login srvc-acct-1 password new-secret\n",
+		},
+		{
+			name:     "Should keep the entries of other client IDs for the same host",
+			existing: "machine sso.redhat.com login srvc-acct-2 password other-secret",
+			want:     "machine sso.redhat.com login srvc-acct-2 password other-secret\nmachine sso.redhat.com login srvc-acct-1 password new-secret\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".netrc")
+			if tt.existing != "" {
+				if err := ioutil.WriteFile(path, []byte(tt.existing), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := WriteNetrc(path, creds); err != nil {
+				t.Fatalf("WriteNetrc() error = %v", err)
+			}
+
+			got, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("WriteNetrc() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
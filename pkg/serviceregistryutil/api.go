@@ -8,6 +8,8 @@ import (
 	srsmgmtv1 "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
 )
 
+const listPageSize = 100
+
 func GetServiceRegistryByID(ctx context.Context, api srsmgmtv1.RegistriesApi, registryID string) (*srsmgmtv1.Registry, *http.Response, error) {
 	request := api.GetRegistry(ctx, registryID)
 	registry, _, err := request.Execute()
@@ -34,3 +36,24 @@ func GetServiceRegistryByName(ctx context.Context, api srsmgmtv1.RegistriesApi,
 
 	return &registryReq, httpResponse, err
 }
+
+// ListAllServiceRegistries fetches all Service Registry instances which the user can access
+func ListAllServiceRegistries(ctx context.Context, api srsmgmtv1.RegistriesApi) ([]srsmgmtv1.Registry, *http.Response, error) {
+	var registries []srsmgmtv1.Registry
+	for page := int32(1); ; page++ {
+		registryList, httpResponse, err := api.GetRegistries(ctx).Page(page).Size(listPageSize).Execute()
+		if httpResponse != nil {
+			httpResponse.Body.Close()
+		}
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		items := registryList.GetItems()
+		registries = append(registries, items...)
+
+		if len(items) == 0 || len(registries) >= int(registryList.GetTotal()) {
+			return registries, httpResponse, nil
+		}
+	}
+}